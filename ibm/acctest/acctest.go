@@ -361,6 +361,7 @@ var (
 
 // ROKS Cluster
 var ClusterName string
+var SecondClusterName string
 
 // Satellite instance
 var (
@@ -1866,6 +1867,11 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IBM_CONTAINER_CLUSTER_NAME for ibm_container_nlb_dns resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	SecondClusterName = os.Getenv("IBM_CONTAINER_SECOND_CLUSTER_NAME")
+	if SecondClusterName == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CONTAINER_SECOND_CLUSTER_NAME for ibm_container_ingress_secret_sync resource else tests will fail if this is not set correctly")
+	}
+
 	Satellite_location_id = os.Getenv("SATELLITE_LOCATION_ID")
 	if Satellite_location_id == "" {
 		fmt.Println("[INFO] Set the environment variable SATELLITE_LOCATION_ID for ibm_cos_bucket satellite location resource or datasource else tests will fail if this is not set correctly")
@@ -221,6 +221,9 @@ type Config struct {
 	Visibility          string
 	PrivateEndpointType string
 	EndpointsFile       string
+
+	// Poll interval of the Power Systems waiters
+	PIPollInterval time.Duration
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -265,6 +268,7 @@ type ClientSession interface {
 	LogsRouterV3() (*logsrouterv3.LogsRouterV3, error)
 	SoftLayerSession() *slsession.Session
 	IBMPISession() (*ibmpisession.IBMPISession, error)
+	PIPollInterval() time.Duration
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
 	EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error)
@@ -420,6 +424,7 @@ type clientSession struct {
 
 	ibmpiConfigErr error
 	ibmpiSession   *ibmpisession.IBMPISession
+	piPollInterval time.Duration
 
 	kpErr error
 	kpAPI *kp.API
@@ -980,6 +985,11 @@ func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
+// PIPollInterval returns the poll interval of the Power Systems waiters, zero when unset
+func (sess clientSession) PIPollInterval() time.Duration {
+	return sess.piPollInterval
+}
+
 // Private DNS Service
 
 func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
@@ -1415,7 +1425,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:        sess,
+		piPollInterval: c.PIPollInterval,
 	}
 
 	if sess.BluemixSession == nil {
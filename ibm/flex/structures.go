@@ -3728,16 +3728,26 @@ func FlattenOpaqueSecret(fields containerv2.Fields) []map[string]interface{} {
 
 // flatten the provided key-value pairs
 func FlattenKeyValues(keyValues []interface{}) map[string]string {
+	labels, err := ParseKeyValues(ExpandStringList(keyValues))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return labels
+}
+
+// ParseKeyValues parses key:value pairs, such as the labels of Satellite
+// hosts, into a map.
+func ParseKeyValues(keyValues []string) (map[string]string, error) {
 	labels := make(map[string]string)
 	for _, v := range keyValues {
-		parts := strings.Split(v.(string), ":")
+		parts := strings.Split(v, ":")
 		if len(parts) != 2 {
-			log.Fatal("Entered key-value " + v.(string) + "is in incorrect format.")
+			return nil, fmt.Errorf("entered key-value %s is in incorrect format, expected key:value", v)
 		}
 		labels[parts[0]] = parts[1]
 	}
-
-	return labels
+	return labels, nil
 }
 
 func FlattenSatelliteZones(zones *schema.Set) []string {
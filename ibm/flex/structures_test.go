@@ -84,3 +84,19 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestParseKeyValues(t *testing.T) {
+	labels, err := ParseKeyValues([]string{"env:prod", "zone:us-south-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "zone": "us-south-1"}, labels)
+
+	labels, err = ParseKeyValues(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+
+	_, err = ParseKeyValues([]string{"env:prod", "cpu"})
+	assert.NotNil(t, err)
+
+	_, err = ParseKeyValues([]string{"url:https://example.com"})
+	assert.NotNil(t, err)
+}
@@ -123,3 +123,13 @@ func FmtErrorf(format string, a ...interface{}) error {
 
 	return intendedError
 }
+
+// PartialCreateDiagnostics reports a failure of a create that happens once
+// the ID is set and part of the resource exists. An error would taint the
+// resource and the next apply would delete what was created so far and start
+// over, so the failure is reported as a warning, followed by the diagnostics
+// of read, and the next apply resumes the creation from the state instead.
+func PartialCreateDiagnostics(warning diag.Diagnostic, read func() diag.Diagnostics) diag.Diagnostics {
+	warning.Severity = diag.Warning
+	return append(diag.Diagnostics{warning}, read()...)
+}
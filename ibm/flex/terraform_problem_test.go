@@ -7,6 +7,7 @@ import (
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -214,6 +215,24 @@ func TestDiscriminatedTerraformErrorf(t *testing.T) {
 	assert.NotEqual(t, terraformProbNoDisc.GetID(), terraformProb.GetID())
 }
 
+func TestPartialCreateDiagnostics(t *testing.T) {
+	warning := diag.Diagnostic{
+		Summary: "Create is incomplete",
+		Detail:  "The next apply resumes the creation.",
+	}
+	read := func() diag.Diagnostics {
+		return diag.Diagnostics{{Severity: diag.Warning, Summary: "Read warning"}}
+	}
+
+	diags := PartialCreateDiagnostics(warning, read)
+	assert.Len(t, diags, 2)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, warning.Summary, diags[0].Summary)
+	assert.Equal(t, warning.Detail, diags[0].Detail)
+	assert.Equal(t, "Read warning", diags[1].Summary)
+}
+
 func TestGetComponentInfo(t *testing.T) {
 	component := getComponentInfo()
 	assert.NotNil(t, component)
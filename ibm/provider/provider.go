@@ -232,6 +232,12 @@ func Provider() *schema.Provider {
 				Description:  "The IBM Cloud account ID",
 				RequiredWith: []string{"iam_profile_name"},
 			},
+			"pi_poll_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 180),
+				Description:  "The interval (in seconds) between the status checks of the Power Systems waiters.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -386,6 +392,7 @@ func Provider() *schema.Provider {
 			"ibm_container_cluster":                         kubernetes.DataSourceIBMContainerCluster(),
 			"ibm_container_cluster_config":                  kubernetes.DataSourceIBMContainerClusterConfig(),
 			"ibm_container_cluster_versions":                kubernetes.DataSourceIBMContainerClusterVersions(),
+			"ibm_container_cluster_upgrade_plan":            kubernetes.DataSourceIBMContainerClusterUpgradePlan(),
 			"ibm_container_cluster_worker":                  kubernetes.DataSourceIBMContainerClusterWorker(),
 			"ibm_container_nlb_dns":                         kubernetes.DataSourceIBMContainerNLBDNS(),
 			"ibm_container_vpc_cluster_alb":                 kubernetes.DataSourceIBMContainerVPCClusterALB(),
@@ -542,6 +549,7 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_endpoint_gateway":     vpc.DataSourceIBMISEndpointGateway(),
 			"ibm_is_instance_template":            vpc.DataSourceIBMISInstanceTemplate(),
 			"ibm_is_instance_templates":           vpc.DataSourceIBMISInstanceTemplates(),
+			"ibm_is_instance_user_data":           vpc.DataSourceIBMIsInstanceUserData(),
 			"ibm_is_instance_profile":             vpc.DataSourceIBMISInstanceProfile(),
 			"ibm_is_instance_profiles":            vpc.DataSourceIBMISInstanceProfiles(),
 			"ibm_is_instance":                     vpc.DataSourceIBMISInstance(),
@@ -672,6 +680,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                     vpc.DataSourceIBMIsNetworkACL(),
 			"ibm_is_network_acl_rule":                vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":               vpc.DataSourceIBMISNetworkACLRules(),
+			"ibm_is_network_path_analysis":           vpc.DataSourceIBMIsNetworkPathAnalysis(),
 			"ibm_lbaas":                              classicinfrastructure.DataSourceIBMLbaas(),
 			"ibm_network_vlan":                       classicinfrastructure.DataSourceIBMNetworkVlan(),
 			"ibm_org":                                cloudfoundry.DataSourceIBMOrg(),
@@ -754,6 +763,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_hosts":                                  power.DataSourceIBMPIHosts(),
 			"ibm_pi_image":                                  power.DataSourceIBMPIImage(),
 			"ibm_pi_images":                                 power.DataSourceIBMPIImages(),
+			"ibm_pi_instance_console":                       power.DataSourceIBMPIInstanceConsole(),
 			"ibm_pi_instance_ip":                            power.DataSourceIBMPIInstanceIP(),
 			"ibm_pi_instance_network":                       power.DataSourceIBMPIInstanceNetwork(),
 			"ibm_pi_instance_networks":                      power.DataSourceIBMPIInstanceNetworks(),
@@ -1284,6 +1294,7 @@ func Provider() *schema.Provider {
 			"ibm_container_ingress_instance":                kubernetes.ResourceIBMContainerIngressInstance(),
 			"ibm_container_ingress_secret_tls":              kubernetes.ResourceIBMContainerIngressSecretTLS(),
 			"ibm_container_ingress_secret_opaque":           kubernetes.ResourceIBMContainerIngressSecretOpaque(),
+			"ibm_container_ingress_secret_sync":             kubernetes.ResourceIBMContainerIngressSecretSync(),
 			"ibm_container_cluster":                         kubernetes.ResourceIBMContainerCluster(),
 			"ibm_container_cluster_feature":                 kubernetes.ResourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                    kubernetes.ResourceIBMContainerBindService(),
@@ -1298,8 +1309,10 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                                cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":                   cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_bucket_cors_configuration":             cos.ResourceIBMCOSBucketCorsConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":        cos.ResourceIBMCOSBucketLifecycleConfiguration(),
 			"ibm_cos_backup_vault":                          cos.ResourceIBMCOSBackupVault(),
 			"ibm_cos_backup_policy":                         cos.ResourceIBMCOSBackupPolicy(),
@@ -1410,6 +1423,7 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                     vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_traffic_shift":                            vpc.ResourceIBMISLBTrafficShift(),
 			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
 			"ibm_is_public_address_range":                        vpc.ResourceIBMPublicAddressRange(),
@@ -1441,6 +1455,7 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_network_interface_floating_ip": vpc.ResourceIBMIsVirtualNetworkInterfaceFloatingIP(),
 			"ibm_is_virtual_network_interface_ip":          vpc.ResourceIBMIsVirtualNetworkInterfaceIP(),
 			"ibm_is_snapshot_consistency_group":            vpc.ResourceIBMIsSnapshotConsistencyGroup(),
+			"ibm_is_snapshot_restore":                      vpc.ResourceIBMISSnapshotRestore(),
 			"ibm_is_volume":                                vpc.ResourceIBMISVolume(),
 			"ibm_is_vpn_gateway":                           vpc.ResourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                vpc.ResourceIBMISVPNGatewayConnection(),
@@ -1535,6 +1550,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_cloud_connection":                power.ResourceIBMPICloudConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_dhcp":                            power.ResourceIBMPIDhcp(),
+			"ibm_pi_dr_pair":                         power.ResourceIBMPIDRPair(),
 			"ibm_pi_host_group":                      power.ResourceIBMPIHostGroup(),
 			"ibm_pi_host":                            power.ResourceIBMPIHost(),
 			"ibm_pi_ike_policy":                      power.ResourceIBMPIIKEPolicy(),
@@ -1569,8 +1585,10 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
 			"ibm_pi_volume_onboarding":               power.ResourceIBMPIVolumeOnboarding(),
 			"ibm_pi_volume":                          power.ResourceIBMPIVolume(),
+			"ibm_pi_volume_set":                      power.ResourceIBMPIVolumeSet(),
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
 			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
+			"ibm_pi_workspace_bootstrap":             power.ResourceIBMPIWorkspaceBootstrap(),
 
 			// Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1659,6 +1677,7 @@ func Provider() *schema.Provider {
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
+			"ibm_satellite_host_pool":                           satellite.ResourceIBMSatelliteHostPool(),
 			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
@@ -2169,6 +2188,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_lb_listener":                                 vpc.ResourceIBMISLBListenerValidator(),
 				"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMemberValidator(),
 				"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPoolValidator(),
+				"ibm_is_lb_traffic_shift":                            vpc.ResourceIBMISLBTrafficShiftValidator(),
 				"ibm_is_lb":                                          vpc.ResourceIBMISLBValidator(),
 				"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACLValidator(),
 				"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRuleValidator(),
@@ -2295,6 +2315,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_ingress_instance":            kubernetes.ResourceIBMContainerIngressInstanceValidator(),
 				"ibm_container_ingress_secret_tls":          kubernetes.ResourceIBMContainerIngressSecretTLSValidator(),
 				"ibm_container_ingress_secret_opaque":       kubernetes.ResourceIBMContainerIngressSecretOpaqueValidator(),
+				"ibm_container_ingress_secret_sync":         kubernetes.ResourceIBMContainerIngressSecretSyncValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
@@ -2396,6 +2417,7 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_is_vpc":                          vpc.DataSourceIBMISVpcValidator(),
 				"ibm_is_volume":                       vpc.DataSourceIBMISVolumeValidator(),
+				"ibm_is_network_path_analysis":        vpc.DataSourceIBMIsNetworkPathAnalysisValidator(),
 				"ibm_cis_webhooks":                    cis.DataSourceIBMCISAlertWebhooksValidator(),
 				"ibm_cis_alerts":                      cis.DataSourceIBMCISAlertsValidator(),
 				"ibm_cis_bot_managements":             cis.DataSourceIBMCISBotManagementValidator(),
@@ -2444,6 +2466,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_database":                        database.DataSourceIBMDatabaseInstanceValidator(),
 
 				"ibm_container_addons":                  kubernetes.DataSourceIBMContainerAddOnsValidator(),
+				"ibm_container_cluster_upgrade_plan":    kubernetes.DataSourceIBMContainerClusterUpgradePlanValidator(),
 				"ibm_container_nlb_dns":                 kubernetes.DataSourceIBMContainerNLBDNSValidator(),
 				"ibm_container_storage_attachment":      kubernetes.DataSourceIBMContainerVpcWorkerVolumeAttachmentValidator(),
 				"ibm_container_vpc_cluster_worker_pool": kubernetes.DataSourceIBMContainerVpcClusterWorkerPoolValidator(),
@@ -2546,6 +2569,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var piPollInterval int
+	if pi, ok := d.GetOk("pi_poll_interval"); ok {
+		piPollInterval = pi.(int)
+	}
 
 	// Apply default values for fields that had DefaultFunc removed for mux compatibility
 	// These defaults match the framework provider's Configure() behavior
@@ -2756,6 +2783,22 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	// pi_poll_interval - check environment variables
+	if piPollInterval == 0 {
+		for _, name := range []string{"IC_PI_POLL_INTERVAL", "IBMCLOUD_PI_POLL_INTERVAL"} {
+			interval := os.Getenv(name)
+			if interval == "" {
+				continue
+			}
+			parsed, err := strconv.Atoi(interval)
+			if err != nil || parsed < 1 || parsed > 180 {
+				return nil, fmt.Errorf("%s must be a number of seconds between 1 and 180, got %q", name, interval)
+			}
+			piPollInterval = parsed
+			break
+		}
+	}
+
 	// max_retries - default: 10
 	if retryCount == 0 {
 		if retries := os.Getenv("MAX_RETRIES"); retries != "" {
@@ -2800,7 +2843,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		IAMTrustedProfileID:   iamTrustedProfileId,
 		IAMTrustedProfileName: iamTrustedProfileName,
 		Account:               account,
+		PIPollInterval:        time.Duration(piPollInterval) * time.Second,
 	}
 
+	// Terraform configures the provider for every plan and apply, the
+	// capacity check only adds up the requests of one of them.
+	power.ResetCapacityPlans()
+
 	return config.ClientSession()
 }
@@ -5,22 +5,30 @@ package provider_framework
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/codeengine"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/satellite"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
 )
 
 // frameworkProvider is the provider implementation for the IBM Cloud Terraform Provider
@@ -61,6 +69,7 @@ type frameworkProviderModel struct {
 	PrivateEndpointType    types.String `tfsdk:"private_endpoint_type"`
 	EndpointsFilePath      types.String `tfsdk:"endpoints_file_path"`
 	IBMCloudAccountID      types.String `tfsdk:"ibmcloud_account_id"`
+	PIPollInterval         types.Int64  `tfsdk:"pi_poll_interval"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -201,6 +210,11 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "The IBM Cloud account ID",
 			},
+			"pi_poll_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The interval (in seconds) between the status checks of the Power Systems waiters.",
+				Validators:  []validator.Int64{int64Between(piPollIntervalMin, piPollIntervalMax)},
+			},
 		},
 	}
 }
@@ -424,6 +438,26 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// pi_poll_interval - check environment variables
+	if config.PIPollInterval.IsNull() {
+		for _, name := range []string{"IC_PI_POLL_INTERVAL", "IBMCLOUD_PI_POLL_INTERVAL"} {
+			interval := os.Getenv(name)
+			if interval == "" {
+				continue
+			}
+			parsed, err := strconv.ParseInt(interval, 10, 64)
+			if err != nil || parsed < piPollIntervalMin || parsed > piPollIntervalMax {
+				resp.Diagnostics.AddError(
+					"Invalid Power Systems Poll Interval",
+					fmt.Sprintf("%s must be a number of seconds between %d and %d, got %q", name, piPollIntervalMin, piPollIntervalMax, interval),
+				)
+				return
+			}
+			config.PIPollInterval = types.Int64Value(parsed)
+			break
+		}
+	}
+
 	// Create conns.Config to initialize client session
 	connConfig := conns.Config{
 		BluemixAPIKey:    apiKey,
@@ -433,6 +467,7 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		RetryCount:       int(config.MaxRetries.ValueInt64()),
 		RetryDelay:       conns.RetryAPIDelay,
 		Visibility:       config.Visibility.ValueString(),
+		PIPollInterval:   time.Duration(config.PIPollInterval.ValueInt64()) * time.Second,
 	}
 
 	// Handle optional fields
@@ -491,8 +526,9 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the client session for resources, data sources, and actions
+	// Set the client session for resources, data sources, ephemeral resources and actions
 	resp.DataSourceData = session
+	resp.EphemeralResourceData = session
 	resp.ResourceData = session
 	resp.ActionData = session
 }
@@ -509,9 +545,50 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		kubernetes.NewContainerClusterConfigEphemeralResource,
+		power.NewPIInstanceConsoleEphemeralResource,
+		satellite.NewSatelliteAttachHostScriptEphemeralResource,
+	}
+}
+
 // Actions defines the actions implemented in the provider.
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		codeengine.NewCodeEngineBuildRunAction,
 	}
 }
+
+// The range of pi_poll_interval, the same as the SDKv2 provider schema.
+const (
+	piPollIntervalMin = 1
+	piPollIntervalMax = 180
+)
+
+// int64BetweenValidator checks that an Int64 attribute is within a range.
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+func int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+func (v int64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
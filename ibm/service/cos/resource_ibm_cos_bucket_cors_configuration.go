@@ -0,0 +1,294 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:     resourceIBMCOSBucketCorsConfigurationRead,
+		Update:   resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    100,
+				Description: "Cross-origin access rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_origins": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Origins that are allowed to make cross-origin requests, for example https://www.example.com or *.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+							},
+							Set:         schema.HashString,
+							Description: "HTTP methods that the origins are allowed to use: GET, PUT, POST, DELETE, HEAD.",
+						},
+						"allowed_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Headers that are allowed in the Access-Control-Request-Headers header of a preflight request.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Response headers that browsers are allowed to access from the application.",
+						},
+						"max_age_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Time in seconds that browsers can cache the response for a preflight request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRuleSetFunction(corsRuleList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsRuleList {
+		ruleMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cors_rule := s3.CORSRule{}
+		if allowedOrigins, ok := ruleMap["allowed_origins"].(*schema.Set); ok && allowedOrigins.Len() > 0 {
+			cors_rule.AllowedOrigins = aws.StringSlice(flex.ExpandStringList(allowedOrigins.List()))
+		}
+		if allowedMethods, ok := ruleMap["allowed_methods"].(*schema.Set); ok && allowedMethods.Len() > 0 {
+			cors_rule.AllowedMethods = aws.StringSlice(flex.ExpandStringList(allowedMethods.List()))
+		}
+		if allowedHeaders, ok := ruleMap["allowed_headers"].(*schema.Set); ok && allowedHeaders.Len() > 0 {
+			cors_rule.AllowedHeaders = aws.StringSlice(flex.ExpandStringList(allowedHeaders.List()))
+		}
+		if exposeHeaders, ok := ruleMap["expose_headers"].(*schema.Set); ok && exposeHeaders.Len() > 0 {
+			cors_rule.ExposeHeaders = aws.StringSlice(flex.ExpandStringList(exposeHeaders.List()))
+		}
+		if maxAgeSeconds, ok := ruleMap["max_age_seconds"].(int); ok && maxAgeSeconds > 0 {
+			cors_rule.MaxAgeSeconds = aws.Int64(int64(maxAgeSeconds))
+		}
+		rules = append(rules, &cors_rule)
+	}
+	return rules
+}
+
+func corsRuleGet(corsRules []*s3.CORSRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(corsRules))
+	for _, corsRule := range corsRules {
+		if corsRule == nil {
+			continue
+		}
+		rule := map[string]interface{}{
+			"allowed_origins": flex.FlattenStringList(aws.StringValueSlice(corsRule.AllowedOrigins)),
+			"allowed_methods": flex.FlattenStringList(aws.StringValueSlice(corsRule.AllowedMethods)),
+			"allowed_headers": flex.FlattenStringList(aws.StringValueSlice(corsRule.AllowedHeaders)),
+			"expose_headers":  flex.FlattenStringList(aws.StringValueSlice(corsRule.ExposeHeaders)),
+		}
+		if corsRule.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(aws.Int64Value(corsRule.MaxAgeSeconds))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func isNoSuchCORSConfiguration(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchCORSConfiguration" {
+		return true
+	}
+	return false
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	putBucketCorsInput := s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRuleSetFunction(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to put CORS configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("cors_rule") {
+		putBucketCorsInput := s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRuleSetFunction(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update CORS configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	const (
+		corsConfigurationSteadyTimeout = 5 * time.Minute
+	)
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	var output *s3.GetBucketCorsOutput
+	// A freshly applied CORS configuration can take a moment to be returned by the bucket.
+	err = resource.Retry(corsConfigurationSteadyTimeout, func() *resource.RetryError {
+		var err error
+		output, err = s3Client.GetBucketCors(getBucketCorsInput)
+		if err != nil && d.IsNewResource() && isNoSuchCORSConfiguration(err) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		output, err = s3Client.GetBucketCors(getBucketCorsInput)
+	}
+	if err != nil {
+		if !d.IsNewResource() && isNoSuchCORSConfiguration(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read CORS configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if output != nil {
+		d.Set("cors_rule", corsRuleGet(output.CORSRules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil && !isNoSuchCORSConfiguration(err) {
+		return fmt.Errorf("failed to delete the CORS configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -6,13 +6,17 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
@@ -36,10 +40,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		UpdateContext: resourceIBMCOSBucketObjectUpdate,
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCOSBucketObjectCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
-			Update: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -96,10 +101,11 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCOSObjectMultipartETag,
+				Description:      "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -151,6 +157,25 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Redirect a request to another object or an URL",
 			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The part size in MiB of multipart uploads. content_file larger than the part size is uploaded in parts",
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of parts of a multipart upload that are uploaded in parallel",
+			},
+			"upload_part_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The part size in bytes of the last upload of content_file, 0 when it was uploaded in a single request",
+			},
 		},
 	}
 }
@@ -175,46 +200,14 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	objectKey := d.Get("key").(string)
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
+	var websiteRedirect *string
 	//if website redirect location if given for a an object
 	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
+		websiteRedirect = aws.String(v.(string))
 	}
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey, websiteRedirect); err != nil {
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
@@ -371,52 +364,16 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
+		var websiteRedirect *string
 		if d.HasChange("website_redirect") {
 			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
+				websiteRedirect = aws.String(v.(string))
 			}
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey, websiteRedirect); err != nil {
+			return diag.FromErr(err)
 		}
-
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
 		putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
@@ -488,6 +445,368 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// resourceIBMCOSBucketObjectCustomizeDiff plans an upload when the content of
+// content_file no longer matches the ETag of the object. The local ETag is
+// computed the same way as COS does, per part for multipart uploads, so the
+// file is streamed and never read into memory.
+func resourceIBMCOSBucketObjectCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChanges("content", "content_base64", "content_file", "etag") {
+		return diff.SetNewComputed("upload_part_size")
+	}
+	path, ok := diff.GetOk("content_file")
+	if !ok || !diff.GetRawConfig().GetAttr("etag").IsNull() {
+		return nil
+	}
+	// The file is compared with the part size of the last upload, so that a
+	// change of multipart_part_size alone does not upload it again
+	objectETag := diff.Get("etag").(string)
+	partSize := cosObjectUploadedPartSize(objectETag, diff.Get("upload_part_size").(int), diff.Get("multipart_part_size").(int))
+	etag, err := cosObjectFileETag(path.(string), partSize)
+	if err != nil {
+		return err
+	}
+	if etag == objectETag {
+		return nil
+	}
+	log.Printf("[INFO] COS object file (%s) ETag %s differs from the object ETag %s", path, etag, objectETag)
+	if configured := int64(diff.Get("multipart_part_size").(int)) * 1024 * 1024; partSize != configured {
+		etag, err = cosObjectFileETag(path.(string), configured)
+		if err != nil {
+			return err
+		}
+	}
+	if err := diff.SetNew("etag", etag); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("upload_part_size")
+}
+
+// suppressCOSObjectMultipartETag suppresses the diff between etag set to the
+// MD5 of content_file and the ETag of an object uploaded in parts, which is
+// never the MD5 of the file, as long as both still match the local file.
+func suppressCOSObjectMultipartETag(k, old, new string, d *schema.ResourceData) bool {
+	path, ok := d.GetOk("content_file")
+	if !ok || new == "" || !cosObjectIsMultipartETag(old) || cosObjectIsMultipartETag(new) {
+		return false
+	}
+	fileMD5, err := cosObjectFileETag(path.(string), 0)
+	if err != nil || fileMD5 != new {
+		return false
+	}
+	etag, err := cosObjectFileETag(path.(string), cosObjectUploadedPartSize(old, d.Get("upload_part_size").(int), d.Get("multipart_part_size").(int)))
+	return err == nil && etag == old
+}
+
+// cosObjectUploadedPartSize returns the part size of the last upload of the
+// object. Objects uploaded before the part size was saved have the MD5 of the
+// whole file as ETag, or were uploaded with multipart_part_size.
+func cosObjectUploadedPartSize(etag string, uploadPartSize, multipartPartSize int) int64 {
+	if uploadPartSize > 0 {
+		return int64(uploadPartSize)
+	}
+	if cosObjectIsMultipartETag(etag) {
+		return int64(multipartPartSize) * 1024 * 1024
+	}
+	return 0
+}
+
+// putCOSBucketObject uploads content, content_base64 or content_file.
+// content_file is streamed from disk, and uploaded in parts when it is larger
+// than the part size.
+func putCOSBucketObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string, websiteRedirect *string) error {
+	var body io.ReadSeeker
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		partSize := cosObjectPartSize(info.Size(), int64(d.Get("multipart_part_size").(int))*1024*1024)
+		if info.Size() > partSize {
+			createInput := &s3.CreateMultipartUploadInput{
+				Bucket:                  aws.String(bucketName),
+				Key:                     aws.String(objectKey),
+				WebsiteRedirectLocation: websiteRedirect,
+			}
+			if err := uploadCOSObjectMultipart(ctx, s3Client, createInput, file, info.Size(), partSize, d.Get("multipart_concurrency").(int), true); err != nil {
+				return err
+			}
+			d.Set("upload_part_size", partSize)
+			return nil
+		}
+		body = file
+	}
+	d.Set("upload_part_size", 0)
+
+	putInput := &s3.PutObjectInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(objectKey),
+		Body:                    body,
+		WebsiteRedirectLocation: websiteRedirect,
+	}
+	if _, err := s3Client.PutObjectWithContext(ctx, putInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// uploadCOSObjectMultipart uploads the file in parts with the object settings
+// of createInput. An incomplete upload of the same key that is left by a
+// failed apply is resumed: its parts that match the MD5 of the local part are
+// kept and only the other parts are uploaded. Incomplete uploads are not
+// aborted on failure so that the next apply can resume them. Use a lifecycle
+// rule to clean up abandoned uploads.
+//
+// COS does not return the settings of an incomplete upload, so the settings of
+// a resumed upload are checked on the completed object, and the file is
+// uploaded again without resuming when they differ from createInput.
+func uploadCOSObjectMultipart(ctx context.Context, s3Client *s3.S3, createInput *s3.CreateMultipartUploadInput, file *os.File, size, partSize int64, concurrency int, resume bool) error {
+	bucketName := aws.StringValue(createInput.Bucket)
+	objectKey := aws.StringValue(createInput.Key)
+	var uploadID string
+	var uploaded map[int64]string
+	if resume {
+		var err error
+		uploadID, uploaded, err = findCOSObjectMultipartUpload(s3Client, bucketName, objectKey)
+		if err != nil {
+			return err
+		}
+	}
+	resumed := uploadID != ""
+	if !resumed {
+		out, err := s3Client.CreateMultipartUploadWithContext(ctx, createInput)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating multipart upload of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		uploadID = aws.StringValue(out.UploadId)
+	} else {
+		log.Printf("[INFO] Resuming multipart upload (%s) of object (%s) in COS bucket (%s) with %d uploaded parts", uploadID, objectKey, bucketName, len(uploaded))
+	}
+
+	partCount := (size + partSize - 1) / partSize
+	completed := make([]*s3.CompletedPart, partCount)
+	errs := make(chan error, partCount)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := int64(0); i < partCount; i++ {
+		partNumber := i + 1
+		offset := i * partSize
+		length := partSize
+		if offset+length > size {
+			length = size - offset
+		}
+
+		wg.Add(1)
+		go func(index, partNumber, offset, length int64) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				errs <- ctx.Err()
+				return
+			}
+			sum, err := cosObjectPartMD5(io.NewSectionReader(file, offset, length))
+			if err != nil {
+				errs <- fmt.Errorf("[ERROR] Error reading part %d of COS object file: %s", partNumber, err)
+				return
+			}
+			if etag, ok := uploaded[partNumber]; ok && etag == hex.EncodeToString(sum) {
+				completed[index] = &s3.CompletedPart{ETag: aws.String(etag), PartNumber: aws.Int64(partNumber)}
+				return
+			}
+			out, err := s3Client.UploadPartWithContext(ctx, &s3.UploadPartInput{
+				Bucket:        aws.String(bucketName),
+				Key:           aws.String(objectKey),
+				UploadId:      aws.String(uploadID),
+				PartNumber:    aws.Int64(partNumber),
+				ContentLength: aws.Int64(length),
+				Body:          io.NewSectionReader(file, offset, length),
+			})
+			if err != nil {
+				errs <- fmt.Errorf("[ERROR] Error uploading part %d of object (%s) in COS bucket (%s): %s", partNumber, objectKey, bucketName, err)
+				return
+			}
+			completed[index] = &s3.CompletedPart{ETag: out.ETag, PartNumber: aws.Int64(partNumber)}
+		}(i, partNumber, offset, length)
+	}
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return fmt.Errorf("%s. The upload (%s) is resumed by the next apply", err, uploadID)
+	}
+
+	_, err := s3Client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String(objectKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error completing multipart upload (%s) of object (%s) in COS bucket (%s): %s", uploadID, objectKey, bucketName, err)
+	}
+
+	if resumed {
+		out, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		if !cosObjectHasUploadSettings(out, createInput) {
+			log.Printf("[INFO] Resumed multipart upload (%s) of object (%s) in COS bucket (%s) had other settings, uploading it again", uploadID, objectKey, bucketName)
+			return uploadCOSObjectMultipart(ctx, s3Client, createInput, file, size, partSize, concurrency, false)
+		}
+	}
+	return nil
+}
+
+// cosObjectHasUploadSettings reports whether the object has the settings of
+// the upload. The content type is only compared when the upload sets it.
+func cosObjectHasUploadSettings(out *s3.HeadObjectOutput, createInput *s3.CreateMultipartUploadInput) bool {
+	if createInput.ContentType != nil && aws.StringValue(out.ContentType) != aws.StringValue(createInput.ContentType) {
+		return false
+	}
+	return aws.StringValue(out.WebsiteRedirectLocation) == aws.StringValue(createInput.WebsiteRedirectLocation) &&
+		aws.StringValue(out.CacheControl) == aws.StringValue(createInput.CacheControl)
+}
+
+// findCOSObjectMultipartUpload returns the latest incomplete multipart upload
+// of the key and the ETags of its uploaded parts by part number.
+func findCOSObjectMultipartUpload(s3Client *s3.S3, bucketName, objectKey string) (string, map[int64]string, error) {
+	out, err := s3Client.ListMultipartUploads(&s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(objectKey),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("[ERROR] Error listing multipart uploads of COS bucket (%s): %s", bucketName, err)
+	}
+	var latest *s3.MultipartUpload
+	for _, upload := range out.Uploads {
+		if aws.StringValue(upload.Key) != objectKey {
+			continue
+		}
+		if latest == nil || aws.TimeValue(upload.Initiated).After(aws.TimeValue(latest.Initiated)) {
+			latest = upload
+		}
+	}
+	if latest == nil {
+		return "", nil, nil
+	}
+
+	uploaded := map[int64]string{}
+	err = s3Client.ListPartsPages(&s3.ListPartsInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(objectKey),
+		UploadId: latest.UploadId,
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			uploaded[aws.Int64Value(part.PartNumber)] = strings.Trim(aws.StringValue(part.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("[ERROR] Error listing parts of multipart upload (%s) in COS bucket (%s): %s", aws.StringValue(latest.UploadId), bucketName, err)
+	}
+	return aws.StringValue(latest.UploadId), uploaded, nil
+}
+
+// cosObjectPartSize returns the part size of an upload. The part size is
+// increased to the next MiB when the file would need more than the maximum
+// of 10000 parts.
+func cosObjectPartSize(size, partSize int64) int64 {
+	const maxParts = 10000
+	const mib = 1024 * 1024
+	if size > partSize*maxParts {
+		partSize = ((size/maxParts)/mib + 1) * mib
+	}
+	return partSize
+}
+
+// cosObjectFileETag returns the ETag that COS gives to the file once
+// uploaded: the MD5 of the file for a single upload, or the MD5 of the part
+// MD5s followed by the number of parts for a multipart upload. A part size of
+// 0 returns the MD5 of the file.
+func cosObjectFileETag(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+	}
+	if partSize > 0 {
+		partSize = cosObjectPartSize(info.Size(), partSize)
+	}
+	if partSize <= 0 || info.Size() <= partSize {
+		sum, err := cosObjectPartMD5(file)
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		return hex.EncodeToString(sum), nil
+	}
+
+	sums := md5.New()
+	parts := 0
+	for offset := int64(0); offset < info.Size(); offset += partSize {
+		sum, err := cosObjectPartMD5(io.NewSectionReader(file, offset, partSize))
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", path, err)
+		}
+		sums.Write(sum)
+		parts++
+	}
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sums.Sum(nil)), parts), nil
+}
+
+// cosObjectIsMultipartETag reports whether the ETag is the one of a multipart
+// upload, which ends with the number of parts.
+func cosObjectIsMultipartETag(etag string) bool {
+	i := strings.LastIndex(etag, "-")
+	if i == -1 {
+		return false
+	}
+	_, err := strconv.Atoi(etag[i+1:])
+	return err == nil
+}
+
+func cosObjectPartMD5(r io.Reader) ([]byte, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		hostUrl := "cloud-object-storage.appdomain.cloud"
@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testMiB = 1024 * 1024
+
+func TestCosObjectPartSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int64
+		partSize int64
+		want     int64
+	}{
+		{
+			name:     "Small file keeps the part size",
+			size:     10 * testMiB,
+			partSize: 100 * testMiB,
+			want:     100 * testMiB,
+		},
+		{
+			name:     "Exactly 10000 parts keeps the part size",
+			size:     10000 * 5 * testMiB,
+			partSize: 5 * testMiB,
+			want:     5 * testMiB,
+		},
+		{
+			name:     "More than 10000 parts grows the part size to a whole MiB",
+			size:     10000*5*testMiB + 1,
+			partSize: 5 * testMiB,
+			want:     6 * testMiB,
+		},
+		{
+			name:     "Large file fits in 10000 parts",
+			size:     5 * 1024 * 1024 * testMiB,
+			partSize: 100 * testMiB,
+			want:     525 * testMiB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cosObjectPartSize(tt.size, tt.partSize)
+			if got != tt.want {
+				t.Errorf("cosObjectPartSize(%d, %d) got %d, want %d", tt.size, tt.partSize, got, tt.want)
+			}
+			if (tt.size+got-1)/got > 10000 {
+				t.Errorf("cosObjectPartSize(%d, %d) got %d, which needs more than 10000 parts", tt.size, tt.partSize, got)
+			}
+		})
+	}
+}
+
+func TestCosObjectFileETag(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 5*testMiB/32)
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	md5Hex := func(b []byte) string {
+		sum := md5.Sum(b)
+		return hex.EncodeToString(sum[:])
+	}
+	multipart := func(partSize int) string {
+		var sums []byte
+		parts := 0
+		for offset := 0; offset < len(content); offset += partSize {
+			end := offset + partSize
+			if end > len(content) {
+				end = len(content)
+			}
+			sum := md5.Sum(content[offset:end])
+			sums = append(sums, sum[:]...)
+			parts++
+		}
+		return fmt.Sprintf("%s-%d", md5Hex(sums), parts)
+	}
+
+	tests := []struct {
+		name     string
+		partSize int64
+		want     string
+	}{
+		{
+			name:     "File smaller than the part size",
+			partSize: 100 * testMiB,
+			want:     md5Hex(content),
+		},
+		{
+			name:     "File larger than the part size",
+			partSize: 1 * testMiB,
+			want:     multipart(1 * testMiB),
+		},
+		{
+			name:     "Part size 0 returns the MD5 of the file",
+			partSize: 0,
+			want:     md5Hex(content),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cosObjectFileETag(path, tt.partSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("cosObjectFileETag() with part size %d, got %s, want %s", tt.partSize, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := cosObjectFileETag(filepath.Join(t.TempDir(), "missing"), testMiB); err == nil {
+		t.Errorf("cosObjectFileETag() of a missing file, expected an error")
+	}
+}
+
+func TestCosObjectIsMultipartETag(t *testing.T) {
+	tests := []struct {
+		etag string
+		want bool
+	}{
+		{"9e107d9d372bb6826bd81d3542a419d6", false},
+		{"9e107d9d372bb6826bd81d3542a419d6-3", true},
+		{"9e107d9d372bb6826bd81d3542a419d6-", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := cosObjectIsMultipartETag(tt.etag); got != tt.want {
+			t.Errorf("cosObjectIsMultipartETag(%q) got %v, want %v", tt.etag, got, tt.want)
+		}
+	}
+}
+
+func TestCosObjectUploadedPartSize(t *testing.T) {
+	tests := []struct {
+		name              string
+		etag              string
+		uploadPartSize    int
+		multipartPartSize int
+		want              int64
+	}{
+		{
+			name:              "Saved part size",
+			etag:              "9e107d9d372bb6826bd81d3542a419d6-3",
+			uploadPartSize:    256 * testMiB,
+			multipartPartSize: 100,
+			want:              256 * testMiB,
+		},
+		{
+			name:              "Multipart upload before the part size was saved",
+			etag:              "9e107d9d372bb6826bd81d3542a419d6-3",
+			multipartPartSize: 100,
+			want:              100 * testMiB,
+		},
+		{
+			name:              "Single upload",
+			etag:              "9e107d9d372bb6826bd81d3542a419d6",
+			multipartPartSize: 100,
+			want:              0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cosObjectUploadedPartSize(tt.etag, tt.uploadPartSize, tt.multipartPartSize); got != tt.want {
+				t.Errorf("cosObjectUploadedPartSize() got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuppressCOSObjectMultipartETag(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789abcdef"), 12*testMiB/16)
+	path := filepath.Join(t.TempDir(), "object")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileMD5, err := cosObjectFileETag(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	multipartETag, err := cosObjectFileETag(path, 5*testMiB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		old            string
+		new            string
+		uploadPartSize int
+		want           bool
+	}{
+		{
+			name:           "File MD5 and multipart ETag of the unchanged file",
+			old:            multipartETag,
+			new:            fileMD5,
+			uploadPartSize: 5 * testMiB,
+			want:           true,
+		},
+		{
+			name:           "Multipart ETag computed with another part size",
+			old:            multipartETag,
+			new:            fileMD5,
+			uploadPartSize: 6 * testMiB,
+			want:           false,
+		},
+		{
+			name:           "MD5 of another file",
+			old:            multipartETag,
+			new:            "9e107d9d372bb6826bd81d3542a419d6",
+			uploadPartSize: 5 * testMiB,
+			want:           false,
+		},
+		{
+			name: "Single upload",
+			old:  "9e107d9d372bb6826bd81d3542a419d6",
+			new:  fileMD5,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceIBMCOSBucketObject().Schema, map[string]interface{}{
+				"bucket_crn":          "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:name",
+				"bucket_location":     "us-south",
+				"key":                 "object",
+				"content_file":        path,
+				"multipart_part_size": 5,
+			})
+			d.Set("upload_part_size", tt.uploadPartSize)
+			if got := suppressCOSObjectMultipartETag("etag", tt.old, tt.new, d); got != tt.want {
+				t.Errorf("suppressCOSObjectMultipartETag() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCosObjectHasUploadSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		out         *s3.HeadObjectOutput
+		createInput *s3.CreateMultipartUploadInput
+		want        bool
+	}{
+		{
+			name:        "Same settings",
+			out:         &s3.HeadObjectOutput{ContentType: aws.String("text/html"), CacheControl: aws.String("no-cache")},
+			createInput: &s3.CreateMultipartUploadInput{ContentType: aws.String("text/html"), CacheControl: aws.String("no-cache")},
+			want:        true,
+		},
+		{
+			name:        "Content type is not compared when the upload does not set it",
+			out:         &s3.HeadObjectOutput{ContentType: aws.String("binary/octet-stream")},
+			createInput: &s3.CreateMultipartUploadInput{},
+			want:        true,
+		},
+		{
+			name:        "Other website redirect",
+			out:         &s3.HeadObjectOutput{WebsiteRedirectLocation: aws.String("/old.html")},
+			createInput: &s3.CreateMultipartUploadInput{WebsiteRedirectLocation: aws.String("/new.html")},
+			want:        false,
+		},
+		{
+			name:        "Other content type",
+			out:         &s3.HeadObjectOutput{ContentType: aws.String("text/plain")},
+			createInput: &s3.CreateMultipartUploadInput{ContentType: aws.String("text/html")},
+			want:        false,
+		},
+		{
+			name:        "Cache control removed",
+			out:         &s3.HeadObjectOutput{CacheControl: aws.String("no-cache")},
+			createInput: &s3.CreateMultipartUploadInput{},
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cosObjectHasUploadSettings(tt.out, tt.createInput); got != tt.want {
+				t.Errorf("cosObjectHasUploadSettings() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"testing"
 	"time"
@@ -70,6 +71,50 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_Multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "multipart.bin")
+	objectFileBody := make([]byte, 12*1024*1024)
+	for i := range objectFileBody {
+		objectFileBody[i] = byte(i)
+	}
+	if err := ioutil.WriteFile(objectFile, objectFileBody, 0644); err != nil {
+		t.Fatal(err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+				),
+			},
+			{
+				PreConfig: func() {
+					objectFileBody[0]++
+					if err := ioutil.WriteFile(objectFile, objectFileBody, 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCOSBucketObject_VersioningEnabled(t *testing.T) {
 	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
 	key := "plaintext.txt"
@@ -321,6 +366,24 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 		}`, name, instanceCRN, objectFile)
 }
 
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn	          = ibm_cos_bucket.testacc.crn
+			bucket_location       = ibm_cos_bucket.testacc.region_location
+			key 			      = "%[1]s.bin"
+			content_file	      = "%[3]s"
+			multipart_part_size   = 5
+			multipart_concurrency = 2
+		}`, name, instanceCRN, objectFile)
+}
+
 func testAccIBMCOSBucketBucketObject_Versioning_Enabled(name string, key string, instanceCRN string, objectBody1 string, objectBody2 string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
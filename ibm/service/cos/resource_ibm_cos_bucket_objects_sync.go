@@ -0,0 +1,447 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory to sync to the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The key prefix of the objects in the bucket",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCOSSyncPattern},
+				Description: "Glob patterns of the files to sync. If not set, all files are synced",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCOSSyncPattern},
+				Description: "Glob patterns of the files not to sync",
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Cache-Control metadata of the objects",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types by file extension, such as .wasm = application/wasm, that override the detected content type",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deletes the objects of files that are removed from the source or no longer match the patterns",
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The part size in MiB of multipart uploads. Files larger than the part size are uploaded in parts",
+			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 64),
+				Description:  "The number of parts of a multipart upload that are uploaded in parallel",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ETag of each synced object by object key",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a local file to sync
+type cosSyncFile struct {
+	path string
+	size int64
+	etag string
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, d.Get("prefix").(string), d.Get("bucket_location").(string)))
+
+	if err := syncCOSBucketObjects(ctx, d, m, map[string]interface{}{}); err != nil {
+		if len(d.Get("files").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+		return flex.PartialCreateDiagnostics(diag.Diagnostic{
+			Summary: fmt.Sprintf("Sync of %s is incomplete", d.Get("source").(string)),
+			Detail:  fmt.Sprintf("%s. The files synced so far are kept in state and the next apply syncs the remaining files.", err),
+		}, func() diag.Diagnostics {
+			return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+		})
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketName, s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// All the synced objects share the prefix, so one listing replaces a
+	// request per object
+	remote := map[string]string{}
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(d.Get("prefix").(string)),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return true
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing COS bucket (%s) objects: %w", bucketName, err))
+	}
+
+	files := map[string]interface{}{}
+	for key, etag := range d.Get("files").(map[string]interface{}) {
+		remoteETag, ok := remote[key]
+		if !ok {
+			log.Printf("[WARN] COS bucket (%s) object (%s) was removed outside of Terraform", bucketName, key)
+			continue
+		}
+		if remoteETag != etag.(string) {
+			log.Printf("[WARN] COS bucket (%s) object (%s) was changed outside of Terraform", bucketName, key)
+		}
+		files[key] = remoteETag
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	old, _ := d.GetChange("files")
+	if err := syncCOSBucketObjects(ctx, d, m, old.(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketName, s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for key := range d.Get("files").(map[string]interface{}) {
+		if err := deleteCOSObjectVersion(s3Client, bucketName, key, "", false); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting object (%s) in COS bucket (%s): %s", key, bucketName, err))
+		}
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectsSyncCustomizeDiff plans an update when the
+// local files no longer match the objects in the bucket.
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	local, err := expandCOSBucketObjectsSyncFiles(diff.Get("source").(string), diff.Get("prefix").(string),
+		flex.ExpandStringList(diff.Get("include").([]interface{})), flex.ExpandStringList(diff.Get("exclude").([]interface{})),
+		int64(diff.Get("multipart_part_size").(int))*1024*1024)
+	if err != nil {
+		return err
+	}
+	state := diff.Get("files").(map[string]interface{})
+	changed := len(local) != len(state)
+	files := map[string]interface{}{}
+	for key, f := range local {
+		files[key] = f.etag
+		if state[key] != f.etag {
+			changed = true
+		}
+	}
+	if changed || diff.HasChanges("cache_control", "content_types") {
+		return diff.SetNew("files", files)
+	}
+	return nil
+}
+
+// syncCOSBucketObjects uploads the files that are new or changed compared to
+// the synced files, and deletes the objects of the removed files when
+// delete_removed is set. The files that are synced so far are saved when a
+// file fails, so the next apply only syncs the remaining files.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}, synced map[string]interface{}) error {
+	bucketName, s3Client, err := getCOSBucketObjectsSyncClient(d, m)
+	if err != nil {
+		return err
+	}
+
+	partSize := int64(d.Get("multipart_part_size").(int)) * 1024 * 1024
+	local, err := expandCOSBucketObjectsSyncFiles(d.Get("source").(string), d.Get("prefix").(string),
+		flex.ExpandStringList(d.Get("include").([]interface{})), flex.ExpandStringList(d.Get("exclude").([]interface{})), partSize)
+	if err != nil {
+		return err
+	}
+
+	files := map[string]interface{}{}
+	for key, etag := range synced {
+		files[key] = etag
+	}
+	metadataChanged := d.HasChanges("cache_control", "content_types")
+	for key, f := range local {
+		if files[key] == f.etag && !metadataChanged {
+			continue
+		}
+		log.Printf("[INFO] Uploading %s to COS bucket (%s) object (%s)", f.path, bucketName, key)
+		if err := uploadCOSBucketObjectsSyncFile(ctx, d, s3Client, bucketName, key, f, partSize); err != nil {
+			d.Set("files", files)
+			return err
+		}
+		files[key] = f.etag
+	}
+
+	for key := range synced {
+		if _, ok := local[key]; ok {
+			continue
+		}
+		if d.Get("delete_removed").(bool) {
+			if err := deleteCOSObjectVersion(s3Client, bucketName, key, "", false); err != nil {
+				d.Set("files", files)
+				return fmt.Errorf("[ERROR] Error deleting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+			}
+		}
+		delete(files, key)
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func uploadCOSBucketObjectsSyncFile(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, key string, f cosSyncFile, partSize int64) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", f.path, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", f.path, err)
+		}
+	}()
+
+	contentType, err := detectCOSObjectContentType(file, d.Get("content_types").(map[string]interface{}))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", f.path, err)
+	}
+	var cacheControl *string
+	if v, ok := d.GetOk("cache_control"); ok {
+		cacheControl = aws.String(v.(string))
+	}
+
+	partSize = cosObjectPartSize(f.size, partSize)
+	if f.size > partSize {
+		createInput := &s3.CreateMultipartUploadInput{
+			Bucket:       aws.String(bucketName),
+			Key:          aws.String(key),
+			ContentType:  aws.String(contentType),
+			CacheControl: cacheControl,
+		}
+		return uploadCOSObjectMultipart(ctx, s3Client, createInput, file, f.size, partSize, d.Get("multipart_concurrency").(int), true)
+	}
+
+	putInput := &s3.PutObjectInput{
+		Bucket:       aws.String(bucketName),
+		Key:          aws.String(key),
+		Body:         file,
+		ContentType:  aws.String(contentType),
+		CacheControl: cacheControl,
+	}
+	if _, err := s3Client.PutObjectWithContext(ctx, putInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// detectCOSObjectContentType returns the content type of the file from the
+// content_types overrides, the file extension or, failing that, the first
+// 512 bytes of the file.
+func detectCOSObjectContentType(file *os.File, overrides map[string]interface{}) (string, error) {
+	ext := strings.ToLower(filepath.Ext(file.Name()))
+	if v, ok := overrides[ext]; ok {
+		return v.(string), nil
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+	buf := make([]byte, 512)
+	n, err := file.Read(buf)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// expandCOSBucketObjectsSyncFiles walks the source directory and returns the
+// files to sync by object key. Patterns are matched against the slash
+// separated path relative to the source, and patterns without a slash are
+// also matched against the file name.
+func expandCOSBucketObjectsSyncFiles(source, prefix string, include, exclude []string, partSize int64) (map[string]cosSyncFile, error) {
+	files := map[string]cosSyncFile{}
+	err := filepath.Walk(source, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		// Symbolic links to files are synced with the content of the file,
+		// the walk does not descend into symbolic links to directories
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(p)
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				log.Printf("[WARN] Skipping symbolic link (%s) to a directory", p)
+				return nil
+			}
+		}
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if len(include) > 0 && !matchCOSSyncPattern(include, rel) {
+			return nil
+		}
+		if matchCOSSyncPattern(exclude, rel) {
+			return nil
+		}
+		etag, err := cosObjectFileETag(p, partSize)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = cosSyncFile{path: p, size: info.Size(), etag: etag}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", source, err)
+	}
+	return files, nil
+}
+
+// matchCOSSyncPattern ignores the errors of path.Match, as the patterns are
+// validated by validateCOSSyncPattern.
+func matchCOSSyncPattern(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateCOSSyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid glob pattern: %s", v.(string), err))
+	}
+	return
+}
+
+func getCOSBucketObjectsSyncClient(d *schema.ResourceData, m interface{}) (string, *s3.S3, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+	if endpointType != "" && endpointType == "private" && schET != "" {
+		endpointType = schET
+	}
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", nil, err
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return "", nil, err
+	}
+	return bucketName, s3Client, nil
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandCOSBucketObjectsSyncFilesSymlinks(t *testing.T) {
+	source := t.TempDir()
+	outside := t.TempDir()
+	if err := os.WriteFile(filepath.Join(source, "index.html"), []byte("index"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outside, "linked.css"), []byte("body {}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "linked.css"), filepath.Join(source, "site.css")); err != nil {
+		t.Skipf("symbolic links are not supported: %s", err)
+	}
+	if err := os.Symlink(outside, filepath.Join(source, "assets")); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := expandCOSBucketObjectsSyncFiles(source, "site/", nil, nil, testMiB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Errorf("expandCOSBucketObjectsSyncFiles() got %d files, want 2: %v", len(files), files)
+	}
+	if f, ok := files["site/site.css"]; !ok || f.size != int64(len("body {}")) {
+		t.Errorf("expandCOSBucketObjectsSyncFiles() got %+v for a symbolic link to a file, want the linked file", f)
+	}
+	if _, ok := files["site/assets"]; ok {
+		t.Errorf("expandCOSBucketObjectsSyncFiles() synced a symbolic link to a directory")
+	}
+}
+
+func TestValidateCOSSyncPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{"*.html", false},
+		{"assets/**/*.png", false},
+		{"[a-z]*.js", false},
+		{"[a-z.js", true},
+		{"*.html\\", true},
+	}
+
+	for _, tt := range tests {
+		_, errs := validateCOSSyncPattern(tt.pattern, "include.0")
+		if (len(errs) > 0) != tt.wantErr {
+			t.Errorf("validateCOSSyncPattern(%q) got errors %v, wantErr %v", tt.pattern, errs, tt.wantErr)
+		}
+	}
+}
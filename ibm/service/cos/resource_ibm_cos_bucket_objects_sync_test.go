@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	source := t.TempDir()
+	writeFile := func(name, body string) {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(source, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(source, name), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html><body>Acceptance Testing</body></html>")
+	writeFile("error.html", "<html><body>Not Found</body></html>")
+	writeFile("css/site.css", "body { color: black; }")
+	writeFile("notes.tmp", "excluded")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "files.%", "3"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "files.site/css/site.css"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects_sync.site", "files.site/notes.tmp"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body>Acceptance Testing 2</body></html>")
+					if err := os.Remove(filepath.Join(source, "error.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "files.%", "2"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects_sync.site", "files.site/error.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, source string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects_sync" "site" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source          = "%[3]s"
+			prefix          = "site/"
+			exclude         = ["*.tmp"]
+			cache_control   = "max-age=300"
+			delete_removed  = true
+		}`, name, instanceCRN, source)
+}
@@ -0,0 +1,417 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// maxWorkerMinorVersionSkew is the number of minor versions that workers can
+// run behind the master
+const maxWorkerMinorVersionSkew = 2
+
+var kubeVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?`)
+
+func DataSourceIBMContainerClusterUpgradePlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerClusterUpgradePlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster Name or ID",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_upgrade_plan",
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the resource group.",
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version to plan the upgrade for. Defaults to the latest supported next version",
+			},
+			"cluster_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the cluster, kubernetes or openshift",
+			},
+			"master_kube_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current version of the cluster master",
+			},
+			"master_end_of_service": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of service date of the current master version",
+			},
+			"next_versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions that the cluster master can be upgraded to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version, as major.minor.patch",
+						},
+						"patch_update": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the version is a patch of the current major.minor version",
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the version is the default version",
+						},
+						"end_of_service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of service date of the version",
+						},
+					},
+				},
+			},
+			"addon_conflicts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The add-ons that block the upgrade to the target version",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The add-on name",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The installed add-on version",
+						},
+						"supported_kube_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The supported kubernetes version range of the add-on version",
+						},
+						"allowed_upgrade_versions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The versions that the add-on can be upgraded to",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the add-on blocks the upgrade, several reasons are separated by semicolons",
+						},
+					},
+				},
+			},
+			"worker_version_skew": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The workers that run a lower version than the master",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker ID",
+						},
+						"pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker pool name",
+						},
+						"actual_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version the worker runs",
+						},
+						"minor_versions_behind": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of minor versions the worker is behind the target version",
+						},
+						"end_of_service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of service date of the worker version",
+						},
+						"blocking": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the worker must be updated before the master can be upgraded to the target version",
+						},
+					},
+				},
+			},
+			"upgrade_blocked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the upgrade to the target version is blocked",
+			},
+			"blocking_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The reasons why the upgrade to the target version is blocked",
+			},
+		},
+	}
+}
+
+func DataSourceIBMContainerClusterUpgradePlanValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerClusterUpgradePlanValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_upgrade_plan", Schema: validateSchema}
+	return &iBMContainerClusterUpgradePlanValidator
+}
+
+func dataSourceIBMContainerClusterUpgradePlanRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	csClientV1, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+	targetEnv, err := getVpcClusterTargetHeader(d)
+	if err != nil {
+		return err
+	}
+	targetEnvV1, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cls, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", cluster, err)
+	}
+	masterVersion, err := parseKubeVersion(cls.MasterKubeVersion)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing master version of cluster %s: %s", cluster, err)
+	}
+
+	versions, response, err := satClient.GetVersions(&kubernetesserviceapiv1.GetVersionsOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the supported versions: %s\n%s", err, response)
+	}
+	versionType := "kubernetes"
+	if cls.Type == "openshift" {
+		versionType = "openshift"
+	}
+
+	// The master can move to a later patch of its minor version or to the
+	// next minor version
+	var masterEndOfService string
+	nextVersions := []map[string]interface{}{}
+	var candidates []*version.Version
+	for _, v := range versions[versionType] {
+		if v.Major == nil || v.Minor == nil || v.Patch == nil {
+			continue
+		}
+		candidate := version.Must(version.NewVersion(fmt.Sprintf("%d.%d.%d", *v.Major, *v.Minor, *v.Patch)))
+		endOfService := ""
+		if v.EndOfService != nil {
+			endOfService = *v.EndOfService
+		}
+		sameMinor := candidate.Segments()[0] == masterVersion.Segments()[0] && candidate.Segments()[1] == masterVersion.Segments()[1]
+		if sameMinor {
+			masterEndOfService = endOfService
+		}
+		nextMinor := candidate.Segments()[0] == masterVersion.Segments()[0] && candidate.Segments()[1] == masterVersion.Segments()[1]+1
+		if (sameMinor && candidate.GreaterThan(masterVersion)) || nextMinor {
+			candidates = append(candidates, candidate)
+			nextVersions = append(nextVersions, map[string]interface{}{
+				"version":        candidate.String(),
+				"patch_update":   sameMinor,
+				"default":        v.Default != nil && *v.Default,
+				"end_of_service": endOfService,
+			})
+		}
+	}
+	sort.SliceStable(nextVersions, func(i, j int) bool {
+		return version.Must(version.NewVersion(nextVersions[i]["version"].(string))).LessThan(version.Must(version.NewVersion(nextVersions[j]["version"].(string))))
+	})
+
+	blockingReasons := []string{}
+	targetVersion := masterVersion
+	if v, ok := d.GetOk("target_version"); ok {
+		targetVersion, err = parseKubeVersion(v.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing target_version: %s", err)
+		}
+		supported := targetVersion.Equal(masterVersion)
+		for _, candidate := range candidates {
+			if candidate.Segments()[0] == targetVersion.Segments()[0] && candidate.Segments()[1] == targetVersion.Segments()[1] {
+				supported = true
+			}
+		}
+		if !supported {
+			blockingReasons = append(blockingReasons, fmt.Sprintf("version %s is not a supported upgrade from master version %s", targetVersion, masterVersion))
+		}
+	} else if len(nextVersions) > 0 {
+		targetVersion = version.Must(version.NewVersion(nextVersions[len(nextVersions)-1]["version"].(string)))
+	}
+
+	// Add-ons are checked against the kubernetes version range they support,
+	// which only applies to kubernetes clusters
+	addOnConflicts := []map[string]interface{}{}
+	addOns, err := csClientV1.AddOns().GetAddons(cluster, targetEnvV1)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving add-ons of cluster %s: %s", cluster, err)
+	}
+	for _, addOn := range addOns {
+		// An add-on can block the upgrade for more than one reason, all of
+		// them are reported
+		reasons := []string{}
+		if addOn.Deprecated {
+			reasons = append(reasons, fmt.Sprintf("add-on version %s is deprecated", addOn.Version))
+		}
+		if versionType == "kubernetes" && addOn.SupportedKubeRange != "" {
+			constraint, err := version.NewConstraint(normalizeKubeVersionRange(addOn.SupportedKubeRange))
+			if err == nil && !constraint.Check(targetVersion) {
+				reasons = append(reasons, fmt.Sprintf("add-on version %s supports %s only", addOn.Version, addOn.SupportedKubeRange))
+			}
+		}
+		minVersion := addOn.MinKubeVersion
+		if versionType == "openshift" {
+			minVersion = addOn.MinOCPVersion
+		}
+		if minVersion != "" {
+			if min, err := parseKubeVersion(minVersion); err == nil && targetVersion.LessThan(min) {
+				reasons = append(reasons, fmt.Sprintf("add-on version %s requires version %s or later", addOn.Version, minVersion))
+			}
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+		addOnConflicts = append(addOnConflicts, map[string]interface{}{
+			"name":                     addOn.Name,
+			"version":                  addOn.Version,
+			"supported_kube_range":     addOn.SupportedKubeRange,
+			"allowed_upgrade_versions": addOn.AllowedUpgradeVersion,
+			"reason":                   strings.Join(reasons, "; "),
+		})
+		for _, reason := range reasons {
+			blockingReasons = append(blockingReasons, fmt.Sprintf("add-on %s: %s", addOn.Name, reason))
+		}
+	}
+
+	workers, err := csClient.Workers().ListAllWorkers(cluster, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	workerSkew := flattenWorkerVersionSkew(workers, masterVersion, targetVersion)
+	for _, worker := range workerSkew {
+		if worker["blocking"].(bool) {
+			blockingReasons = append(blockingReasons, fmt.Sprintf("worker %s runs version %s, more than %d minor versions behind %s", worker["worker_id"], worker["actual_version"], maxWorkerMinorVersionSkew, targetVersion))
+		}
+	}
+
+	d.SetId(cls.ID)
+	d.Set("resource_group_id", cls.ResourceGroupID)
+	d.Set("target_version", targetVersion.String())
+	d.Set("cluster_type", cls.Type)
+	d.Set("master_kube_version", cls.MasterKubeVersion)
+	d.Set("master_end_of_service", masterEndOfService)
+	d.Set("next_versions", nextVersions)
+	d.Set("addon_conflicts", addOnConflicts)
+	d.Set("worker_version_skew", workerSkew)
+	d.Set("upgrade_blocked", len(blockingReasons) > 0)
+	d.Set("blocking_reasons", blockingReasons)
+	return nil
+}
+
+// flattenWorkerVersionSkew lists the workers that are behind the master. A
+// worker blocks the upgrade when it would end up more than
+// maxWorkerMinorVersionSkew minor versions behind the target version.
+func flattenWorkerVersionSkew(workers []v2.Worker, masterVersion, targetVersion *version.Version) []map[string]interface{} {
+	skew := []map[string]interface{}{}
+	for _, worker := range workers {
+		actual, err := parseKubeVersion(worker.KubeVersion.Actual)
+		if err != nil || !actual.LessThan(masterVersion) {
+			continue
+		}
+		behind := 0
+		if actual.Segments()[0] == targetVersion.Segments()[0] {
+			behind = targetVersion.Segments()[1] - actual.Segments()[1]
+		}
+		skew = append(skew, map[string]interface{}{
+			"worker_id":             worker.ID,
+			"pool_name":             worker.PoolName,
+			"actual_version":        worker.KubeVersion.Actual,
+			"minor_versions_behind": behind,
+			"end_of_service":        worker.KubeVersion.Eos,
+			"blocking":              behind > maxWorkerMinorVersionSkew,
+		})
+	}
+	return skew
+}
+
+// parseKubeVersion parses the major.minor.patch part of versions such as
+// 1.30.5_1540 or 4.16.12_1550_openshift
+func parseKubeVersion(v string) (*version.Version, error) {
+	match := kubeVersionRegexp.FindStringSubmatch(strings.TrimPrefix(v, "v"))
+	if match == nil {
+		return nil, fmt.Errorf("invalid version %q", v)
+	}
+	patch := match[3]
+	if patch == "" {
+		patch = "0"
+	}
+	return version.NewVersion(fmt.Sprintf("%s.%s.%s", match[1], match[2], patch))
+}
+
+// normalizeKubeVersionRange turns a space separated range such as
+// ">=1.26.0 <1.32.0" into the comma separated form of go-version
+func normalizeKubeVersionRange(r string) string {
+	constraints := []string{}
+	operator := ""
+	for _, field := range strings.Fields(r) {
+		if strings.Trim(field, "<>=!~") == "" {
+			operator = field
+			continue
+		}
+		constraints = append(constraints, operator+field)
+		operator = ""
+	}
+	return strings.Join(constraints, ",")
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterUpgradePlanDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterUpgradePlanDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_upgrade_plan.plan", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_upgrade_plan.plan", "master_kube_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_upgrade_plan.plan", "target_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_upgrade_plan.plan", "upgrade_blocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterUpgradePlanDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster_upgrade_plan" "plan" {
+		cluster = "%s"
+	}
+`, acc.IksClusterID)
+}
@@ -0,0 +1,404 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/yaml.v3"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &containerClusterConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &containerClusterConfigEphemeralResource{}
+)
+
+func NewContainerClusterConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &containerClusterConfigEphemeralResource{}
+}
+
+// containerClusterConfigEphemeralResource fetches the cluster connection
+// details in memory. Nothing is written to disk or persisted in plan or state.
+type containerClusterConfigEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type containerClusterConfigModel struct {
+	ClusterNameID    types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID  types.String `tfsdk:"resource_group_id"`
+	Admin            types.Bool   `tfsdk:"admin"`
+	EndpointType     types.String `tfsdk:"endpoint_type"`
+	Host             types.String `tfsdk:"host"`
+	CACertificate    types.String `tfsdk:"ca_certificate"`
+	Token            types.String `tfsdk:"token"`
+	AdminCertificate types.String `tfsdk:"admin_certificate"`
+	AdminKey         types.String `tfsdk:"admin_key"`
+}
+
+// clusterKubeconfig holds the parts of a kubeconfig that are needed to build
+// the connection details.
+type clusterKubeconfig struct {
+	Clusters []struct {
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificate     string `yaml:"client-certificate"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKey             string `yaml:"client-key"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			AuthProvider          *struct {
+				Config map[string]string `yaml:"config"`
+			} `yaml:"auth-provider"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func (e *containerClusterConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_container_cluster_config"
+}
+
+func (e *containerClusterConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the connection details of a cluster in memory without writing the kubeconfig to disk or storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/id of the cluster",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true will return the admin client certificate and key instead of a token",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "It can specify what kind of server URL will be used for the cluster context",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the cluster API server.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded certificate authority of the cluster API server.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The short-lived bearer token of the caller.",
+			},
+			"admin_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client certificate. Only set when admin is true.",
+			},
+			"admin_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client key. Only set when admin is true.",
+			},
+		},
+	}
+}
+
+func (e *containerClusterConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.session = session
+}
+
+func (e *containerClusterConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data containerClusterConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	csClient, err := e.session.VpcContainerAPI()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Container Client", err.Error())
+		return
+	}
+	bmxSess, err := e.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Bluemix Session", err.Error())
+		return
+	}
+
+	name := data.ClusterNameID.ValueString()
+	admin := data.Admin.ValueBool()
+	endpointType := data.EndpointType.ValueString()
+	targetEnv := v2.ClusterTargetHeader{
+		ResourceGroup: data.ResourceGroupID.ValueString(),
+	}
+
+	cls, err := csClient.Clusters().GetCluster(name, targetEnv)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Cluster", fmt.Sprintf("GetCluster of %s failed: %s", name, err))
+		return
+	}
+	if cls.Provider == "satellite" {
+		// Satellite clusters are only reachable with the admin config over the link endpoint
+		endpointType = "link"
+		admin = true
+	}
+
+	var kubeconfig map[string][]byte
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		var err error
+		kubeconfig, err = e.getKubeconfigArchive(ctx, cls.ID, targetEnv.ResourceGroup, bmxSess.Config.IAMRefreshToken, admin, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if intermittentUserLookupFailure, _ := regexp.MatchString("lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Fetch Cluster Config", fmt.Sprintf("Fetching the cluster config of %s failed: %s", name, err))
+		return
+	}
+
+	var config clusterKubeconfig
+	for fileName, content := range kubeconfig {
+		if strings.HasSuffix(fileName, ".yml") || strings.HasSuffix(fileName, ".yaml") {
+			if err := yaml.Unmarshal(content, &config); err != nil {
+				resp.Diagnostics.AddError("Unable to Parse Cluster Config", fmt.Sprintf("Parsing %s of cluster %s failed: %s", fileName, name, err))
+				return
+			}
+		}
+	}
+	if len(config.Clusters) == 0 || len(config.Users) == 0 {
+		resp.Diagnostics.AddError("Unable to Parse Cluster Config", fmt.Sprintf("The cluster config of %s does not contain a cluster or user", name))
+		return
+	}
+
+	cluster := config.Clusters[0].Cluster
+	user := config.Users[0].User
+	data.Host = types.StringValue(cluster.Server)
+	data.CACertificate = types.StringValue(kubeconfigFileContent(kubeconfig, cluster.CertificateAuthorityData, cluster.CertificateAuthority))
+	data.Token = types.StringNull()
+	data.AdminCertificate = types.StringNull()
+	data.AdminKey = types.StringNull()
+
+	if admin {
+		data.AdminCertificate = types.StringValue(kubeconfigFileContent(kubeconfig, user.ClientCertificateData, user.ClientCertificate))
+		data.AdminKey = types.StringValue(kubeconfigFileContent(kubeconfig, user.ClientKeyData, user.ClientKey))
+	} else if cls.Type == "openshift" {
+		if bmxSess.Config.BluemixAPIKey == "" {
+			resp.Diagnostics.AddError("Unable to Fetch OpenShift Token", fmt.Sprintf("Logging in to OpenShift cluster %s requires an IBM Cloud API key. Set admin to true to use the admin client certificate instead", name))
+			return
+		}
+		token, err := fetchOpenShiftClusterToken(ctx, cluster.Server, bmxSess.Config.BluemixAPIKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Fetch OpenShift Token", fmt.Sprintf("Logging in to OpenShift cluster %s failed: %s", name, err))
+			return
+		}
+		data.Token = types.StringValue(token)
+		// The OpenShift API server presents a publicly signed certificate to token clients
+		data.CACertificate = types.StringNull()
+	} else {
+		token := user.Token
+		if user.AuthProvider != nil {
+			token = user.AuthProvider.Config["id-token"]
+		}
+		data.Token = types.StringValue(token)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// getKubeconfigArchive calls applyRBACAndGetKubeconfig and returns the files of
+// the zip archive in the response, keyed by file name.
+func (e *containerClusterConfigEphemeralResource) getKubeconfigArchive(ctx context.Context, clusterID, resourceGroup, refreshToken string, admin bool, endpointType string) (map[string][]byte, error) {
+	satClient, err := e.session.SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(ctx)
+	_, err = builder.ResolveRequestURL(satClient.Service.Options.URL, `/v2/applyRBACAndGetKubeconfig`, nil)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/zip")
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddHeader("X-Auth-Refresh-Token", refreshToken)
+	if resourceGroup != "" {
+		builder.AddHeader("X-Auth-Resource-Group", resourceGroup)
+	}
+
+	body := map[string]interface{}{
+		"cluster": clusterID,
+		"format":  "zip",
+	}
+	if admin {
+		body["admin"] = true
+	}
+	if endpointType != "" {
+		body["endpointType"] = endpointType
+	}
+	_, err = builder.SetBodyContentJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var result io.ReadCloser
+	_, err = satClient.Service.Request(request, &result)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	content, err := io.ReadAll(result)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("the cluster config is not a valid zip archive: %s", err)
+	}
+	files := make(map[string][]byte, len(archive.File))
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		fileContent, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[f.FileInfo().Name()] = fileContent
+	}
+	return files, nil
+}
+
+// kubeconfigFileContent returns the inline base64 data of a kubeconfig field
+// if set, otherwise the content of the archive file it references.
+func kubeconfigFileContent(files map[string][]byte, inline, path string) string {
+	if inline != "" {
+		decoded, err := base64.StdEncoding.DecodeString(inline)
+		if err == nil {
+			return string(decoded)
+		}
+	}
+	if path == "" {
+		return ""
+	}
+	parts := strings.Split(path, "/")
+	return string(files[parts[len(parts)-1]])
+}
+
+// fetchOpenShiftClusterToken logs in to the OpenShift OAuth server of the
+// cluster with an API key and returns the issued access token.
+func fetchOpenShiftClusterToken(ctx context.Context, server, apiKey string) (string, error) {
+	client := &http.Client{
+		Timeout: 60 * time.Second,
+		// The token is returned in the fragment of the redirect location
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(server, "/")+"/.well-known/oauth-authorization-server", nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode > 299 {
+		msg, _ := io.ReadAll(response.Body)
+		return "", fmt.Errorf("bad status code [%d] returned when fetching cluster authentication endpoints: %s", response.StatusCode, msg)
+	}
+	var authEndpoints struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&authEndpoints); err != nil {
+		return "", err
+	}
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodGet, authEndpoints.AuthorizationEndpoint+"?response_type=token&client_id=openshift-challenging-client", nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("apikey:"+apiKey)))
+	response, err = client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusFound {
+		msg, _ := io.ReadAll(response.Body)
+		return "", fmt.Errorf("bad status code [%d] returned when logging in to openshift: %s", response.StatusCode, msg)
+	}
+	location, err := response.Location()
+	if err != nil {
+		return "", err
+	}
+	values, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		return "", err
+	}
+	token := values.Get("access_token")
+	if token == "" {
+		return "", fmt.Errorf("no access token returned when logging in to openshift")
+	}
+	return token, nil
+}
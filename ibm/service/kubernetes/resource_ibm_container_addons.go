@@ -57,6 +57,47 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 				Default:     true,
 				Description: "To manage all add-ons installed in the cluster using terraform by importing it into the state file",
 			},
+			"wait_for_normal_health": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until every managed add-on reports normal health, instead of any settled health state",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The worst health state of the managed add-ons",
+			},
+			"applied_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The add-on versions installed by the last apply, by add-on name",
+			},
+			"version_drift": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The add-ons whose version was changed outside of terraform since the last apply",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The addon name",
+						},
+						"expected_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The addon version installed by the last apply",
+						},
+						"actual_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The addon version installed in the cluster",
+						},
+					},
+				},
+			},
 			"managed_addons": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -145,6 +186,12 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 							},
 							Description: "Add-On parameters to pass in a JSON string format.",
 						},
+						"parameters": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Add-On parameters to set in the add-on options. Changes made outside of terraform are detected",
+						},
 					},
 				},
 			},
@@ -200,6 +247,8 @@ func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during create (%s) : %s", d.Id(), err)
 	}
 	d.SetId(cluster)
+	// The versions installed now are the applied versions read compares with.
+	d.Set("applied_versions", nil)
 
 	return resourceIBMContainerAddOnsRead(d, meta)
 }
@@ -208,7 +257,8 @@ func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{})
 func getOptions(addOn map[string]interface{}, d *schema.ResourceData, meta interface{}) (string, error) {
 
 	// Check if parameters are given in terraform, if no, then return
-	if addOn["parameters_json"] == "" {
+	parameters, _ := addOn["parameters"].(map[string]interface{})
+	if (addOn["parameters_json"] == nil || addOn["parameters_json"] == "") && len(parameters) == 0 {
 		return "", nil
 	}
 
@@ -219,8 +269,13 @@ func getOptions(addOn map[string]interface{}, d *schema.ResourceData, meta inter
 
 	addOnAPI := csClient.AddOns()
 
-	var addonParams map[string]interface{}
-	json.Unmarshal([]byte(addOn["parameters_json"].(string)), &addonParams)
+	addonParams := map[string]interface{}{}
+	if parametersJSON, ok := addOn["parameters_json"].(string); ok && parametersJSON != "" {
+		json.Unmarshal([]byte(parametersJSON), &addonParams)
+	}
+	for k, v := range parameters {
+		addonParams[k] = v
+	}
 
 	// Get the list of Addons with their parameters
 	addOnList, err := addOnAPI.ListAddons()
@@ -444,11 +499,100 @@ func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		fmt.Printf("Error Flattening Addons list %s", err)
 	}
+	addOns, appliedVersions, versionDrift := flattenAddOnsDrift(d, addOns)
 	d.Set("resource_group_id", targetEnv.ResourceGroup)
 	d.Set("addons", addOns)
+	d.Set("applied_versions", appliedVersions)
+	d.Set("version_drift", versionDrift)
+	d.Set("health", addOnsHealth(addOns))
 	return nil
 }
 
+// flattenAddOnsDrift compares the add-ons read from the cluster with the ones
+// in the state. The versions are compared with applied_versions, which only
+// changes on apply, so a version changed outside of terraform is logged and
+// returned on every refresh until the next apply. The configured parameters
+// are refreshed from the add-on options so that changes to them show up in
+// the plan.
+func flattenAddOnsDrift(d *schema.ResourceData, addOns *schema.Set) (*schema.Set, map[string]interface{}, []map[string]interface{}) {
+	priorVersions := d.Get("applied_versions").(map[string]interface{})
+	appliedVersions := map[string]interface{}{}
+	known := map[string]map[string]interface{}{}
+	if v, ok := d.GetOk("addons"); ok {
+		for _, aoSet := range v.(*schema.Set).List() {
+			ao := aoSet.(map[string]interface{})
+			known[ao["name"].(string)] = ao
+		}
+	}
+
+	versionDrift := []map[string]interface{}{}
+	records := []interface{}{}
+	for _, aoSet := range addOns.List() {
+		record := aoSet.(map[string]interface{})
+		// An add-on without an applied version, after an apply or an import,
+		// starts from the installed version.
+		appliedVersions[record["name"].(string)] = record["version"]
+		expected, _ := priorVersions[record["name"].(string)].(string)
+		if expected != "" && expected != record["version"].(string) {
+			appliedVersions[record["name"].(string)] = expected
+			log.Printf("[WARN] The version of addon %s changed outside of terraform from %s to %s", record["name"], expected, record["version"])
+			versionDrift = append(versionDrift, map[string]interface{}{
+				"name":             record["name"],
+				"expected_version": expected,
+				"actual_version":   record["version"],
+			})
+		}
+		prior, ok := known[record["name"].(string)]
+		if !ok {
+			records = append(records, record)
+			continue
+		}
+		record["parameters_json"] = prior["parameters_json"]
+		if parameters, ok := prior["parameters"].(map[string]interface{}); ok && len(parameters) > 0 {
+			options := addOnOptionsData(record["options"])
+			actual := map[string]interface{}{}
+			for k, v := range parameters {
+				actual[k] = v
+				if value, ok := options[k]; ok {
+					actual[k] = fmt.Sprint(value)
+				}
+			}
+			record["parameters"] = actual
+		}
+		records = append(records, record)
+	}
+	return schema.NewSet(resourceIBMContainerAddonsHash, records), appliedVersions, versionDrift
+}
+
+// addOnOptionsData returns the data section of the add-on options, which is
+// returned either as a YAML document or as an object
+func addOnOptionsData(options interface{}) map[string]interface{} {
+	var parsed map[string]interface{}
+	switch o := options.(type) {
+	case string:
+		yaml.Unmarshal([]byte(o), &parsed)
+	case map[string]interface{}:
+		parsed = o
+	}
+	if data, ok := parsed["data"].(map[string]interface{}); ok {
+		return data
+	}
+	return parsed
+}
+
+// addOnsHealth returns the worst health state of the given add-ons
+func addOnsHealth(addOns *schema.Set) string {
+	severity := map[string]int{"normal": 0, "pending": 1, "updating": 1, "warning": 2, "critical": 3}
+	health := ""
+	for _, aoSet := range addOns.List() {
+		state, _ := aoSet.(map[string]interface{})["health_state"].(string)
+		if health == "" || severity[state] > severity[health] {
+			health = state
+		}
+	}
+	return health
+}
+
 func flattenAddOn(d *schema.ResourceData, result []v1.AddOn) (resp *schema.Set, err error) {
 	managed_addons := d.Get("managed_addons").([]interface{})
 	addOns := []interface{}{}
@@ -480,7 +624,7 @@ func flattenAddOn(d *schema.ResourceData, result []v1.AddOn) (resp *schema.Set,
 
 				record["vlan_spanning_required"] = addOn.VlanSpanningRequired
 
-				record["options"] = addOn.Options
+				record["options"] = flattenAddOnOptions(addOn.Options)
 
 				addOns = append(addOns, record)
 				break
@@ -519,13 +663,27 @@ func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
 
 		record["vlan_spanning_required"] = addOn.VlanSpanningRequired
 
-		record["options"] = addOn.Options
+		record["options"] = flattenAddOnOptions(addOn.Options)
 
 		addOns = append(addOns, record)
 	}
 
 	return schema.NewSet(resourceIBMContainerAddonsHash, addOns), nil
 }
+
+// flattenAddOnOptions returns the add-on options as a string
+func flattenAddOnOptions(options interface{}) string {
+	switch o := options.(type) {
+	case nil:
+		return ""
+	case string:
+		return o
+	default:
+		out, _ := yaml.Marshal(o)
+		return string(out)
+	}
+}
+
 func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{}) error {
 	manageAllAddons := d.Get("manage_all_addons").(bool)
 	managed_addons := d.Get("managed_addons").([]interface{})
@@ -624,7 +782,23 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 						os.Remove(oA)
 
 					} else {
-						return fmt.Errorf("[ERROR] The given addon is not provided with upgradable or updatable version")
+						return fmt.Errorf("[ERROR] The version of addon %s is %s, which cannot be changed to %s. If the addon was updated outside of terraform, set the version to %s or to one of its allowed_upgrade_versions", newPack["name"], oldPack["version"], newPack["version"], oldPack["version"])
+					}
+				} else if strings.Compare(newPack["name"].(string), oldPack["name"].(string)) == 0 && addOnParametersChanged(oldPack, newPack) {
+					// Same version with new parameters, update the add-on options
+					options, err := getOptions(newPack, d, meta)
+					if err != nil {
+						return err
+					}
+					updateList := v1.ConfigureAddOns{Update: true}
+					updateList.AddonsList = append(updateList.AddonsList, v1.AddOn{
+						Name:    newPack["name"].(string),
+						Version: newPack["version"].(string),
+						Options: options,
+					})
+					_, err = addOnAPI.ConfigureAddons(cluster, &updateList, targetEnv)
+					if err != nil {
+						return fmt.Errorf("[ERROR] Error updating the parameters of addon %s on %s during update : %s", newPack["name"], d.Id(), err)
 					}
 				}
 			}
@@ -687,10 +861,29 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 	} else {
 		d.Set("managed_addons", nil)
 	}
+	d.Set("applied_versions", nil)
 
 	return resourceIBMContainerAddOnsRead(d, meta)
 }
 
+// addOnParametersChanged reports whether the parameters of an add-on differ
+func addOnParametersChanged(oldPack, newPack map[string]interface{}) bool {
+	if oldPack["parameters_json"] != newPack["parameters_json"] {
+		return true
+	}
+	oldParameters, _ := oldPack["parameters"].(map[string]interface{})
+	newParameters, _ := newPack["parameters"].(map[string]interface{})
+	if len(oldParameters) != len(newParameters) {
+		return true
+	}
+	for k, v := range newParameters {
+		if oldParameters[k] != v {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceIBMContainerAddOnsDelete(d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
@@ -722,9 +915,21 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		return false, err
 	}
 
+	waitForNormal := d.Get("wait_for_normal_health").(bool)
+	managed := map[string]bool{}
+	for _, aoSet := range d.Get("addons").(*schema.Set).List() {
+		managed[aoSet.(map[string]interface{})["name"].(string)] = true
+	}
+	pending := []string{"pending", "updating", ""}
+	target := []string{"normal", "warning", "critical", "available"}
+	if waitForNormal {
+		pending = append(pending, "warning", "critical")
+		target = []string{"available"}
+	}
+
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending", "updating", ""},
-		Target:  []string{"normal", "warning", "critical", "available"},
+		Pending: pending,
+		Target:  target,
 		Refresh: func() (interface{}, string, error) {
 			targetEnv, err := getClusterTargetHeader(d, meta)
 			if err != nil {
@@ -742,6 +947,10 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 				if addOn.HealthState == "pending" || addOn.HealthState == "updating" || addOn.HealthState == "" {
 					return addOns, addOn.HealthState, nil
 				}
+				if waitForNormal && managed[addOn.Name] && addOn.HealthState != "normal" {
+					log.Printf("[DEBUG] Addon %s health is %s: %s", addOn.Name, addOn.HealthState, addOn.HealthStatus)
+					return addOns, "pending", nil
+				}
 			}
 			return addOns, "available", nil
 		},
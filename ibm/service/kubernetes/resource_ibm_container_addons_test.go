@@ -49,6 +49,38 @@ func TestAccIBMContainerAddOns_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerAddOns_Parameters(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-addon-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerAddOnsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerAddOnsParameters(name, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "addons.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "health", "normal"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "version_drift.#", "0"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "applied_versions.%", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerAddOnsParameters(name, "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "health", "normal"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerAddOnsDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_container_addons" {
@@ -140,3 +172,40 @@ func testAccCheckIBMContainerAddOnsUpdate(name string) string {
 		}
 }`, name)
 }
+
+func testAccCheckIBMContainerAddOnsParameters(name, scanInterval string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_addons" "addons" {
+		cluster                = ibm_container_vpc_cluster.cluster.id
+		wait_for_normal_health = true
+		addons {
+			name       = "cluster-autoscaler"
+			parameters = {
+				scanInterval = "%[2]s"
+			}
+		}
+}`, name, scanInterval)
+}
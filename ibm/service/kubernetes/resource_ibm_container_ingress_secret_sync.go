@@ -0,0 +1,521 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMContainerIngressSecretSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMContainerIngressSecretSyncCreate,
+		ReadContext:   resourceIBMContainerIngressSecretSyncRead,
+		UpdateContext: resourceIBMContainerIngressSecretSyncUpdate,
+		DeleteContext: resourceIBMContainerIngressSecretSyncDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerIngressSecretSyncCustomizeDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cert_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CRN of the Secrets Manager certificate to sync",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the TLS secret to create in each target",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_secret_sync",
+					"secret_name",
+				),
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Persistence of the secrets",
+			},
+			"register_instance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Registers the Secrets Manager instance of the certificate with each target cluster if it is not registered yet",
+			},
+			"targets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The clusters and namespaces to sync the certificate to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Cluster ID or name",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Secret namespace",
+						},
+					},
+				},
+			},
+			"cert_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the certificate in Secrets Manager, which increases on each rotation",
+			},
+			"target_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the secret in each target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster ID or name",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Secret namespace",
+						},
+						"cert_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate CRN of the secret",
+						},
+						"synced_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The certificate version that was last synced to the target",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Secret Status",
+						},
+						"expires_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate expires on date",
+						},
+						"last_updated_timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp secret was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerIngressSecretSyncValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema, validate.ValidateSchema{
+		Identifier:                 "secret_name",
+		ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+		Type:                       validate.TypeString,
+		Required:                   true,
+		Regexp:                     `^([a-z0-9]([-a-z0-9]*[a-z0-9])?(.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*)$`,
+		MinValueLength:             1,
+		MaxValueLength:             63,
+	})
+	iBMContainerIngressSecretSyncValidator := validate.ResourceValidator{ResourceName: "ibm_container_ingress_secret_sync", Schema: validateSchema}
+	return &iBMContainerIngressSecretSyncValidator
+}
+
+// ingressSecretSyncTarget is the secret of a cluster namespace
+type ingressSecretSyncTarget struct {
+	cluster              string
+	namespace            string
+	certCRN              string
+	syncedVersion        int
+	status               string
+	expiresOn            string
+	lastUpdatedTimestamp string
+}
+
+func (t ingressSecretSyncTarget) key() string {
+	return fmt.Sprintf("%s/%s", t.cluster, t.namespace)
+}
+
+func resourceIBMContainerIngressSecretSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, _, _, err := parseIngressSecretSyncCertCRN(d.Get("cert_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// cert_crn is updated in place, so the ID does not include the certificate
+	d.SetId(d.Get("secret_name").(string))
+
+	err = syncIBMContainerIngressSecrets(d, meta)
+	if syncErr, ok := err.(*ingressSecretSyncTargetsError); ok {
+		return flex.PartialCreateDiagnostics(diag.Diagnostic{
+			Summary: fmt.Sprintf("Ingress secret %s is not synced to %d target(s), the next apply retries them", syncErr.secretName, len(syncErr.failures)),
+			Detail:  strings.Join(syncErr.failures, "\n"),
+		}, func() diag.Diagnostics {
+			return resourceIBMContainerIngressSecretSyncRead(ctx, d, meta)
+		})
+	}
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	return resourceIBMContainerIngressSecretSyncRead(ctx, d, meta)
+}
+
+func resourceIBMContainerIngressSecretSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ingressAPI := ingressClient.Ingresses()
+	secretName := d.Get("secret_name").(string)
+
+	// The secrets in the targets can still be read when Secrets Manager cannot,
+	// the last cert_version is kept until the metadata is available again
+	certVersion, err := getIngressSecretSyncCertVersion(meta, d.Get("cert_crn").(string))
+	if err != nil {
+		log.Printf("[WARN] Keeping cert_version %d of ingress secret %s: %s", d.Get("cert_version").(int), secretName, err)
+	} else {
+		d.Set("cert_version", certVersion)
+	}
+
+	targets := []ingressSecretSyncTarget{}
+	for _, t := range expandIngressSecretSyncTargetStatus(d) {
+		ingressSecretConfig, err := ingressAPI.GetIngressSecret(t.cluster, secretName, t.namespace)
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				log.Printf("[WARN] Ingress secret %s was removed from %s", secretName, t.key())
+				continue
+			}
+			return diag.Errorf("[ERROR] Error getting ingress secret %s of %s: %s", secretName, t.key(), err)
+		}
+		if ingressSecretConfig.Status == "deleted" {
+			log.Printf("[WARN] Ingress secret %s was removed from %s", secretName, t.key())
+			continue
+		}
+		t.certCRN = ingressSecretConfig.CRN
+		t.status = ingressSecretConfig.Status
+		t.expiresOn = ingressSecretConfig.ExpiresOn
+		t.lastUpdatedTimestamp = ingressSecretConfig.LastUpdatedTimestamp
+		targets = append(targets, t)
+	}
+	setIngressSecretSyncTargetStatus(d, targets)
+
+	return nil
+}
+
+func resourceIBMContainerIngressSecretSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := syncIBMContainerIngressSecrets(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMContainerIngressSecretSyncRead(ctx, d, meta)
+}
+
+func resourceIBMContainerIngressSecretSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ingressAPI := ingressClient.Ingresses()
+	secretName := d.Get("secret_name").(string)
+
+	for _, t := range expandIngressSecretSyncTargetStatus(d) {
+		err := deleteIngressSecretSyncTarget(ingressAPI, secretName, t)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMContainerIngressSecretSyncCustomizeDiff forces an update when a
+// target is missing or holds an older version of the certificate, for example
+// after the certificate is rotated in Secrets Manager.
+func resourceIBMContainerIngressSecretSyncCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	certVersion := diff.Get("cert_version").(int)
+	synced := map[string]int{}
+	for _, s := range diff.Get("target_status").([]interface{}) {
+		status := s.(map[string]interface{})
+		synced[fmt.Sprintf("%s/%s", status["cluster"], status["namespace"])] = status["synced_version"].(int)
+	}
+	for _, t := range diff.Get("targets").(*schema.Set).List() {
+		target := t.(map[string]interface{})
+		version, ok := synced[fmt.Sprintf("%s/%s", target["cluster"], target["namespace"])]
+		if !ok || version < certVersion {
+			return diff.SetNewComputed("target_status")
+		}
+	}
+	return nil
+}
+
+// syncIBMContainerIngressSecrets creates the secret in the new targets,
+// updates the targets that hold an older certificate and deletes the secret
+// from the removed targets. A failing target does not stop the others. The
+// status of each target is saved, so failed targets are retried by the next
+// apply. Target failures are returned as ingressSecretSyncTargetsError.
+func syncIBMContainerIngressSecrets(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	ingressAPI := ingressClient.Ingresses()
+	secretName := d.Get("secret_name").(string)
+	certCRN := d.Get("cert_crn").(string)
+
+	certVersion, err := getIngressSecretSyncCertVersion(meta, certCRN)
+	if err != nil {
+		return err
+	}
+	d.Set("cert_version", certVersion)
+
+	current := map[string]ingressSecretSyncTarget{}
+	for _, t := range expandIngressSecretSyncTargetStatus(d) {
+		current[t.key()] = t
+	}
+
+	desired := map[string]bool{}
+	synced := []ingressSecretSyncTarget{}
+	failures := []string{}
+	registered := map[string]bool{}
+	for _, v := range d.Get("targets").(*schema.Set).List() {
+		target := v.(map[string]interface{})
+		t := ingressSecretSyncTarget{
+			cluster:   target["cluster"].(string),
+			namespace: target["namespace"].(string),
+		}
+		desired[t.key()] = true
+
+		if d.Get("register_instance").(bool) && !registered[t.cluster] {
+			err := registerIngressSecretSyncInstance(ingressAPI, t.cluster, certCRN)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", t.key(), err))
+				continue
+			}
+			registered[t.cluster] = true
+		}
+
+		existing, ok := current[t.key()]
+		switch {
+		case !ok:
+			params := v2.SecretCreateConfig{
+				Cluster:   t.cluster,
+				Name:      secretName,
+				Namespace: t.namespace,
+				Type:      "TLS",
+				CRN:       certCRN,
+			}
+			if persistence, ok := d.GetOk("persistence"); ok {
+				params.Persistence = persistence.(bool)
+			}
+			_, err := ingressAPI.CreateIngressSecret(params)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", t.key(), err))
+				continue
+			}
+		case existing.certCRN != certCRN || existing.syncedVersion < certVersion:
+			params := v2.SecretUpdateConfig{
+				Cluster:   t.cluster,
+				Name:      secretName,
+				Namespace: t.namespace,
+				CRN:       certCRN,
+			}
+			_, err := ingressAPI.UpdateIngressSecret(params)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", t.key(), err))
+				synced = append(synced, existing)
+				continue
+			}
+		default:
+			synced = append(synced, existing)
+			continue
+		}
+		t.certCRN = certCRN
+		t.syncedVersion = certVersion
+		synced = append(synced, t)
+	}
+
+	for key, t := range current {
+		if desired[key] {
+			continue
+		}
+		err := deleteIngressSecretSyncTarget(ingressAPI, secretName, t)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", key, err))
+			synced = append(synced, t)
+		}
+	}
+	setIngressSecretSyncTargetStatus(d, synced)
+
+	if len(failures) > 0 {
+		return &ingressSecretSyncTargetsError{secretName: secretName, failures: failures}
+	}
+	return nil
+}
+
+// ingressSecretSyncTargetsError reports the targets that failed to sync.
+type ingressSecretSyncTargetsError struct {
+	secretName string
+	failures   []string
+}
+
+func (e *ingressSecretSyncTargetsError) Error() string {
+	return fmt.Sprintf("[ERROR] Error syncing ingress secret %s to %d target(s): %s", e.secretName, len(e.failures), strings.Join(e.failures, "; "))
+}
+
+func deleteIngressSecretSyncTarget(ingressAPI v2.Ingress, secretName string, t ingressSecretSyncTarget) error {
+	params := v2.SecretDeleteConfig{
+		Cluster:   t.cluster,
+		Name:      secretName,
+		Namespace: t.namespace,
+	}
+	err := ingressAPI.DeleteIngressSecret(params)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting ingress secret %s of %s: %s", secretName, t.key(), err)
+	}
+	return nil
+}
+
+// registerIngressSecretSyncInstance registers the Secrets Manager instance of
+// the certificate with the cluster, unless it is registered already.
+func registerIngressSecretSyncInstance(ingressAPI v2.Ingress, cluster, certCRN string) error {
+	parts := strings.Split(certCRN, ":")
+	instanceCRN := strings.Join(parts[:8], ":") + "::"
+
+	instances, err := ingressAPI.GetIngressInstanceList(cluster, false)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing ingress instances: %s", err)
+	}
+	for _, instance := range instances {
+		if instance.CRN == instanceCRN {
+			return nil
+		}
+	}
+	_, err = ingressAPI.RegisterIngressInstance(v2.InstanceRegisterConfig{
+		Cluster: cluster,
+		CRN:     instanceCRN,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error registering ingress instance %s: %s", instanceCRN, err)
+	}
+	return nil
+}
+
+// parseIngressSecretSyncCertCRN returns the region, instance ID and secret
+// ID of a Secrets Manager certificate CRN, for example
+// crn:v1:bluemix:public:secrets-manager:us-south:a/123456:<instance ID>:secret:<secret ID>
+func parseIngressSecretSyncCertCRN(crn string) (string, string, string, error) {
+	parts := strings.Split(crn, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[4] != "secrets-manager" || parts[8] != "secret" {
+		return "", "", "", fmt.Errorf("[ERROR] %q is not the CRN of a Secrets Manager secret", crn)
+	}
+	return parts[5], parts[7], parts[9], nil
+}
+
+// getIngressSecretSyncCertVersion returns the number of versions of the
+// certificate, which increases each time the certificate is rotated.
+func getIngressSecretSyncCertVersion(meta interface{}, crn string) (int, error) {
+	region, instanceID, secretID, err := parseIngressSecretSyncCertCRN(crn)
+	if err != nil {
+		return 0, err
+	}
+
+	client, err := secretsmanager.GetClientForInstance(meta.(conns.ClientSession), instanceID, region)
+	if err != nil {
+		return 0, err
+	}
+
+	metadata, response, err := client.GetSecretMetadata(&secretsmanagerv2.GetSecretMetadataOptions{ID: &secretID})
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error getting certificate %s: %s\n%s", secretID, err, response)
+	}
+	var versionsTotal *int64
+	switch m := metadata.(type) {
+	case *secretsmanagerv2.ImportedCertificateMetadata:
+		versionsTotal = m.VersionsTotal
+	case *secretsmanagerv2.PrivateCertificateMetadata:
+		versionsTotal = m.VersionsTotal
+	case *secretsmanagerv2.PublicCertificateMetadata:
+		versionsTotal = m.VersionsTotal
+	default:
+		return 0, fmt.Errorf("[ERROR] Secret %s is not a certificate", secretID)
+	}
+	if versionsTotal == nil {
+		return 0, nil
+	}
+	return int(*versionsTotal), nil
+}
+
+func expandIngressSecretSyncTargetStatus(d *schema.ResourceData) []ingressSecretSyncTarget {
+	targets := []ingressSecretSyncTarget{}
+	for _, v := range d.Get("target_status").([]interface{}) {
+		status := v.(map[string]interface{})
+		targets = append(targets, ingressSecretSyncTarget{
+			cluster:              status["cluster"].(string),
+			namespace:            status["namespace"].(string),
+			certCRN:              status["cert_crn"].(string),
+			syncedVersion:        status["synced_version"].(int),
+			status:               status["status"].(string),
+			expiresOn:            status["expires_on"].(string),
+			lastUpdatedTimestamp: status["last_updated_timestamp"].(string),
+		})
+	}
+	return targets
+}
+
+func setIngressSecretSyncTargetStatus(d *schema.ResourceData, targets []ingressSecretSyncTarget) {
+	status := make([]map[string]interface{}, 0, len(targets))
+	for _, t := range targets {
+		status = append(status, map[string]interface{}{
+			"cluster":                t.cluster,
+			"namespace":              t.namespace,
+			"cert_crn":               t.certCRN,
+			"synced_version":         t.syncedVersion,
+			"status":                 t.status,
+			"expires_on":             t.expiresOn,
+			"last_updated_timestamp": t.lastUpdatedTimestamp,
+		})
+	}
+	d.Set("target_status", status)
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerIngressSecretSync_Basic(t *testing.T) {
+	secretName := fmt.Sprintf("tf-container-ingress-secret-sync-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretSyncBasic(secretName, acc.CertCRN, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "secret_name", secretName),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.0.status", "created"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_container_ingress_secret_sync.sync", "target_status.*", map[string]string{
+							"cluster":   acc.SecondClusterName,
+							"namespace": "default",
+						}),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_secret_sync.sync", "cert_version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretSyncBasic(secretName, acc.UpdatedCertCRN, "kube-system"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "cert_crn", acc.UpdatedCertCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.0.cert_crn", acc.UpdatedCertCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.1.cert_crn", acc.UpdatedCertCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_sync.sync", "target_status.2.cert_crn", acc.UpdatedCertCRN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressSecretSyncDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_secret_sync" {
+			continue
+		}
+
+		ingressClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		ingressAPI := ingressClient.Ingresses()
+
+		secretName := rs.Primary.Attributes["secret_name"]
+		for _, cluster := range []string{acc.ClusterName, acc.SecondClusterName} {
+			for _, namespace := range []string{"ibm-cert-store", "default", "kube-system"} {
+				resp, err := ingressAPI.GetIngressSecret(cluster, secretName, namespace)
+				if err == nil && resp.Status == "deleted" {
+					continue
+				} else if err == nil || !strings.Contains(err.Error(), "404") {
+					return fmt.Errorf("[ERROR] Error checking if secret (%s/%s/%s) has been destroyed: %s", cluster, namespace, secretName, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressSecretSyncBasic(secretName, certCRN, namespace string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_ingress_secret_sync" "sync" {
+  secret_name = "%[1]s"
+  cert_crn    = "%[2]s"
+  persistence = true
+
+  targets {
+    cluster   = "%[3]s"
+    namespace = "ibm-cert-store"
+  }
+  targets {
+    cluster   = "%[3]s"
+    namespace = "%[4]s"
+  }
+  targets {
+    cluster   = "%[5]s"
+    namespace = "default"
+  }
+}`, secretName, certCRN, acc.ClusterName, namespace, acc.SecondClusterName)
+}
@@ -6,11 +6,13 @@ package kubernetes
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -35,6 +37,7 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -233,6 +236,62 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Set:              flex.ResourceIBMVPCHash,
 				DiffSuppressFunc: flex.ApplyOnce,
 			},
+
+			"update_all_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Updates all the woker nodes of the worker pool if sets to true",
+			},
+
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version",
+			},
+
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
+			},
+
+			"worker_update_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Controls how worker nodes are replaced during an update",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of extra workers per zone added to the worker pool during the update",
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of workers per zone that can be unavailable at the same time during the update",
+						},
+						"batch_by_zone": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Update the workers of one zone at a time instead of all zones together",
+						},
+						"wait_for_ready": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Wait for the replacement workers to be Ready before replacing the next batch",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -543,9 +602,192 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("operating_system") {
+		updateAllWorkers := d.Get("update_all_workers").(bool)
+		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+			targetEnv, err := getVpcClusterTargetHeader(d)
+			if err != nil {
+				return err
+			}
+			if err := updateVpcWorkerPoolWorkers(d, meta, clusterNameOrID, workerPoolName, targetEnv); err != nil {
+				d.Set("patch_version", nil)
+				return err
+			}
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
+// updateVpcWorkerPoolWorkers replaces the workers of the pool that are not at
+// the target version or operating system, in batches sized by the
+// worker_update_policy. With max_surge the pool is temporarily resized so that
+// capacity never drops below worker_count minus max_unavailable in a zone.
+func updateVpcWorkerPoolWorkers(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolName string, targetEnv v2.ClusterTargetHeader) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	maxSurge, maxUnavailable, batchByZone, waitForReady := 0, 1, false, true
+	if v, ok := d.GetOk("worker_update_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policy := v.([]interface{})[0].(map[string]interface{})
+		maxSurge = policy["max_surge"].(int)
+		maxUnavailable = policy["max_unavailable"].(int)
+		batchByZone = policy["batch_by_zone"].(bool)
+		waitForReady = policy["wait_for_ready"].(bool)
+	}
+	if maxSurge+maxUnavailable < 1 {
+		return fmt.Errorf("[ERROR] worker_update_policy requires max_surge or max_unavailable to be greater than 0")
+	}
+
+	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterNameOrID, workerPoolName, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+	}
+	workers, err := csClient.Workers().ListByWorkerPool(clusterNameOrID, workerPoolName, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	// outdated holds the workers to replace per zone, zones keeps their order
+	outdated := make(map[string][]string)
+	zones := []string{}
+	for _, worker := range workers {
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != workerPool.OperatingSystem {
+			if _, ok := outdated[worker.Location]; !ok {
+				zones = append(zones, worker.Location)
+			}
+			outdated[worker.Location] = append(outdated[worker.Location], worker.ID)
+		}
+	}
+	if len(zones) == 0 {
+		log.Printf("[DEBUG] All workers of worker pool (%s) are up to date", workerPoolName)
+		return nil
+	}
+	sort.Strings(zones)
+
+	workerCount := workerPool.WorkerCount
+	expected := workerCount * len(workerPool.Zones)
+	ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+	if maxSurge > 0 {
+		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, workerCount+maxSurge, Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding surge workers to worker pool (%s): %s", workerPoolName, err)
+		}
+		expected = (workerCount + maxSurge) * len(workerPool.Zones)
+		_, err = waitForVpcWorkerPoolWorkersReplaced(d, meta, clusterNameOrID, workerPoolName, nil, expected, true, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for surge workers of worker pool (%s): %s", workerPoolName, err)
+		}
+	}
+
+	// batches are built per zone, either one zone after the other or across
+	// all zones at once
+	batchSize := maxSurge + maxUnavailable
+	batches := [][]string{}
+	if batchByZone {
+		for _, zone := range zones {
+			for start := 0; start < len(outdated[zone]); start += batchSize {
+				end := start + batchSize
+				if end > len(outdated[zone]) {
+					end = len(outdated[zone])
+				}
+				batches = append(batches, outdated[zone][start:end])
+			}
+		}
+	} else {
+		for start := 0; ; start += batchSize {
+			batch := []string{}
+			for _, zone := range zones {
+				for i := start; i < start+batchSize && i < len(outdated[zone]); i++ {
+					batch = append(batch, outdated[zone][i])
+				}
+			}
+			if len(batch) == 0 {
+				break
+			}
+			batches = append(batches, batch)
+		}
+	}
+
+	for index, batch := range batches {
+		log.Printf("[INFO] Replacing batch %d of %d of worker pool (%s): %v", index+1, len(batches), workerPoolName, batch)
+		for _, workerID := range batch {
+			_, err := csClient.Workers().ReplaceWokerNode(clusterNameOrID, workerID, targetEnv)
+			// As API returns http response 204 NO CONTENT, error raised will be exempted.
+			if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+				return fmt.Errorf("[ERROR] Error replacing the worker node (%s) from the worker pool: %s", workerID, err)
+			}
+		}
+		_, err = waitForVpcWorkerPoolWorkersReplaced(d, meta, clusterNameOrID, workerPoolName, batch, expected, waitForReady, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for workers %v of worker pool (%s) to be replaced: %s", batch, workerPoolName, err)
+		}
+	}
+
+	if maxSurge > 0 {
+		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, workerCount, Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error removing surge workers from worker pool (%s): %s", workerPoolName, err)
+		}
+		_, err = waitForVpcWorkerPoolWorkersReplaced(d, meta, clusterNameOrID, workerPoolName, nil, workerCount*len(workerPool.Zones), false, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for surge workers of worker pool (%s) to be removed: %s", workerPoolName, err)
+		}
+	}
+	return nil
+}
+
+// waitForVpcWorkerPoolWorkersReplaced waits until none of the replaced workers
+// is left in the pool and the pool has the expected number of workers. With
+// waitForReady all workers must also be deployed and in normal health.
+func waitForVpcWorkerPoolWorkersReplaced(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolName string, replaced []string, expected int, waitForReady bool, targetEnv v2.ClusterTargetHeader) (interface{}, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := csClient.Workers().ListByWorkerPool(clusterNameOrID, workerPoolName, false, targetEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			count := 0
+			for _, worker := range workers {
+				for _, id := range replaced {
+					if worker.ID == id {
+						log.Printf("worker: %s state: %s", worker.ID, worker.LifeCycle.ActualState)
+						return workers, versionUpdating, nil
+					}
+				}
+				if worker.LifeCycle.ActualState == "deleting" || worker.LifeCycle.ActualState == "deleted" {
+					continue
+				}
+				if waitForReady && (worker.LifeCycle.ActualState != "deployed" || worker.Health.State != workerNormal) {
+					log.Printf("worker: %s state: %s health: %s", worker.ID, worker.LifeCycle.ActualState, worker.Health.State)
+					return workers, versionUpdating, nil
+				}
+				count++
+			}
+			if count != expected {
+				return workers, versionUpdating, nil
+			}
+			return workers, workerNormal, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
 func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -113,7 +113,7 @@ func TestAccIBMContainerVpcClusterWorkerPoolResourceBasic(t *testing.T) {
 				ResourceName:            "ibm_container_vpc_worker_pool.test_pool",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"orphan_on_delete", "import_on_create"},
+				ImportStateVerifyIgnore: []string{"orphan_on_delete", "import_on_create", "update_all_workers"},
 			},
 			{
 				Config:  testAccCheckIBMVpcContainerWorkerPoolUpdate(name),
@@ -239,6 +239,79 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(cluster_name string) string {
 }
 
 // TestAccIBMContainerVpcClusterWorkerPoolResourceSecurityGroups ...
+func TestAccIBMContainerVpcClusterWorkerPoolResourceUpdatePolicy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-wp-policy-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdatePolicy(name, "UBUNTU_20_64"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_count", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_update_policy.0.max_surge", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_update_policy.0.max_unavailable", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdatePolicy(name, "UBUNTU_24_64"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "operating_system", "UBUNTU_24_64"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolUpdatePolicy(name, operatingSystem string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[3]s"
+	  vpc_id            = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  wait_till         = "MasterNodeReady"
+	  zones {
+		subnet_id = "%[2]s"
+		name      = "us-south-1"
+	  }
+	}
+
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster            = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name   = "%[3]s-wp"
+	  flavor             = "cx2.2x4"
+	  vpc_id             = "%[1]s"
+	  worker_count       = 2
+	  resource_group_id  = data.ibm_resource_group.resource_group.id
+	  operating_system   = "%[4]s"
+	  update_all_workers = true
+	  zones {
+		name      = "us-south-1"
+		subnet_id = "%[2]s"
+	  }
+	  worker_update_policy {
+		max_surge       = 1
+		max_unavailable = 0
+		wait_for_ready  = true
+	  }
+	}
+		`, acc.IksClusterVpcID, acc.IksClusterSubnetID, name, operatingSystem)
+}
+
 func TestAccIBMContainerVpcClusterWorkerPoolResourceSecurityGroups(t *testing.T) {
 
 	name := fmt.Sprintf("tf-vpc-wp-secgroup-%d", acctest.RandIntRange(10, 100))
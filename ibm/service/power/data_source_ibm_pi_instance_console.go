@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Datasource to get the console URL and the boot status of an instance
+func DataSourceIBMPIInstanceConsole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceConsoleRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceID: {
+				Description:  "The ID of the PVM instance.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ConsoleURL: {
+				Computed:    true,
+				Description: "The URL to access the console of the instance, it is stored in plain text in the state. Use the ibm_pi_instance_console ephemeral resource to keep it out of the state.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			Attr_Fault: {
+				Computed:    true,
+				Description: "Fault information.",
+				Type:        schema.TypeMap,
+			},
+			Attr_HealthLastUpdate: {
+				Computed:    true,
+				Description: "The date and time of the last health update of the instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_HealthReason: {
+				Computed:    true,
+				Description: "The reason of the health status of the instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_HealthStatus: {
+				Computed:    true,
+				Description: "The health of the instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_Progress: {
+				Computed:    true,
+				Description: "The progress of an operation on the instance.",
+				Type:        schema.TypeFloat,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the instance.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceConsoleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "(Data) ibm_pi_instance_console", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_InstanceID).(string)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvmInstance, err := client.Get(instanceID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "(Data) ibm_pi_instance_console", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	console, err := client.PostConsoleURL(instanceID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("PostConsoleURL failed: %s", err.Error()), "(Data) ibm_pi_instance_console", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *pvmInstance.PvmInstanceID))
+	d.Set(Attr_ConsoleURL, console.ConsoleURL)
+	d.Set(Attr_Progress, pvmInstance.Progress)
+	d.Set(Attr_Status, pvmInstance.Status)
+	if pvmInstance.Fault != nil {
+		d.Set(Attr_Fault, flattenPvmInstanceFault(pvmInstance.Fault))
+	} else {
+		d.Set(Attr_Fault, nil)
+	}
+	if pvmInstance.Health != nil {
+		d.Set(Attr_HealthLastUpdate, pvmInstance.Health.LastUpdate)
+		d.Set(Attr_HealthReason, pvmInstance.Health.Reason)
+		d.Set(Attr_HealthStatus, pvmInstance.Health.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIInstanceConsoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceConsoleDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_console.example", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_console.example", "console_url"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_console.example", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceConsoleDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_instance_console" "example" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_id       = "%[2]s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_id)
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &piInstanceConsoleEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &piInstanceConsoleEphemeralResource{}
+)
+
+func NewPIInstanceConsoleEphemeralResource() ephemeral.EphemeralResource {
+	return &piInstanceConsoleEphemeralResource{}
+}
+
+// piInstanceConsoleEphemeralResource generates a console URL that is never
+// persisted in plan or state.
+type piInstanceConsoleEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type piInstanceConsoleModel struct {
+	CloudInstanceID types.String `tfsdk:"pi_cloud_instance_id"`
+	InstanceID      types.String `tfsdk:"pi_instance_id"`
+	ConsoleURL      types.String `tfsdk:"console_url"`
+	HealthStatus    types.String `tfsdk:"health_status"`
+	Status          types.String `tfsdk:"status"`
+}
+
+func (e *piInstanceConsoleEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_pi_instance_console"
+}
+
+func (e *piInstanceConsoleEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a console URL of a Power Virtual Server instance without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the service instance associated with an account.",
+			},
+			Arg_InstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the PVM instance.",
+			},
+			Attr_ConsoleURL: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL to access the console of the instance.",
+			},
+			Attr_HealthStatus: schema.StringAttribute{
+				Computed:    true,
+				Description: "The health of the instance.",
+			},
+			Attr_Status: schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the instance.",
+			},
+		},
+	}
+}
+
+func (e *piInstanceConsoleEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Cast provider data to ClientSession (same pattern as SDKv2 resources)
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.session = session
+}
+
+func (e *piInstanceConsoleEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data piInstanceConsoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sess, err := e.session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Power Client", err.Error())
+		return
+	}
+
+	instanceID := data.InstanceID.ValueString()
+	client := instance.NewIBMPIInstanceClient(ctx, sess, data.CloudInstanceID.ValueString())
+	pvmInstance, err := client.Get(instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Power Instance", fmt.Sprintf("Get of instance %s failed: %s", instanceID, err))
+		return
+	}
+	console, err := client.PostConsoleURL(instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Console URL", fmt.Sprintf("PostConsoleURL of instance %s failed: %s", instanceID, err))
+		return
+	}
+
+	data.ConsoleURL = types.StringPointerValue(console.ConsoleURL)
+	data.Status = types.StringPointerValue(pvmInstance.Status)
+	data.HealthStatus = types.StringNull()
+	if pvmInstance.Health != nil {
+		data.HealthStatus = types.StringValue(pvmInstance.Health.Status)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstanceConsoleEphemeralResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The provisioner fails unless the ephemeral resource returns a
+				// console URL and a status
+				Config: testAccCheckIBMPIInstanceConsoleEphemeralResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.console", "id"),
+					testAccCheckIBMPIInstanceConsoleNotInState,
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceConsoleNotInState(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "ibm_pi_instance_console" {
+			return fmt.Errorf("[ERROR] ephemeral resource ibm_pi_instance_console was stored in state")
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPIInstanceConsoleEphemeralResourceConfig() string {
+	return fmt.Sprintf(`
+		ephemeral "ibm_pi_instance_console" "example" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_id       = "%[2]s"
+		}
+
+		resource "terraform_data" "console" {
+			provisioner "local-exec" {
+				command = "test -n \"$CONSOLE_URL\" && test -n \"$STATUS\""
+				environment = {
+					CONSOLE_URL = ephemeral.ibm_pi_instance_console.example.console_url
+					STATUS      = ephemeral.ibm_pi_instance_console.example.status
+				}
+			}
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_id)
+}
@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The capacity check aggregates the instances and volumes planned in a
+// workspace. Every resource with pi_capacity_check records its request when it
+// is planned, and the sum of the requests of the current plan or apply is
+// checked against the free capacity of the workspace. Terraform configures the
+// provider once for every plan and apply, which resets the requests.
+//
+// The plugin SDK does not pass the resource address to a plan, so existing
+// resources are recorded under their ID and new resources under their name
+// and a sequence number, as count often plans several of them with the same
+// name. A request is released once its resource is applied, and the free
+// capacity is read again, so the applied resource is not counted twice.
+var piCapacityPlans = struct {
+	sync.Mutex
+	snapshots map[string]*piCapacitySnapshot
+	requests  map[string]map[string]piCapacityRequest
+}{
+	snapshots: map[string]*piCapacitySnapshot{},
+	requests:  map[string]map[string]piCapacityRequest{},
+}
+
+type piCapacitySnapshot struct {
+	SystemPools  models.SystemPools
+	StoragePools *models.StoragePoolsCapacity
+}
+
+type piCapacityRequest struct {
+	// Instance requests, cores and memory are the total of all replicants.
+	SysType         string
+	Cores           float64
+	Memory          float64
+	InstanceCores   float64
+	InstanceMemory  float64
+	InstanceRequest bool
+	StoragePool     string
+	StorageType     string
+	VolumeSize      float64
+	VolumeRequest   bool
+	Name            string
+	// BaseName is the name of a new resource without the sequence number.
+	BaseName string
+}
+
+// piInstanceCapacityRequest returns the cores and memory an instance plan adds
+// to the workspace, false when they are not known at plan time.
+func piInstanceCapacityRequest(diff *schema.ResourceDiff) (piCapacityRequest, bool) {
+	if _, ok := diff.GetOk(Arg_SAPProfileID); ok {
+		log.Printf("[INFO] Capacity check skipped for SAP instance %s, the profile cores and memory are not known at plan time", diff.Get(Arg_InstanceName).(string))
+		return piCapacityRequest{}, false
+	}
+	for _, key := range []string{Arg_InstanceName, Arg_Processors, Arg_Memory, Arg_SysType, Arg_Replicants} {
+		if !diff.NewValueKnown(key) {
+			return piCapacityRequest{}, false
+		}
+	}
+	oldProcs, newProcs := diff.GetChange(Arg_Processors)
+	oldMem, newMem := diff.GetChange(Arg_Memory)
+	replicants := float64(diff.Get(Arg_Replicants).(int))
+	request := piCapacityRequest{
+		SysType:         diff.Get(Arg_SysType).(string),
+		InstanceCores:   newProcs.(float64),
+		InstanceMemory:  newMem.(float64),
+		InstanceRequest: true,
+		Name:            fmt.Sprintf("instance %s", diff.Get(Arg_InstanceName).(string)),
+	}
+	if diff.Id() == "" {
+		request.Cores = request.InstanceCores * replicants
+		request.Memory = request.InstanceMemory * replicants
+	} else {
+		// A resize only needs the growth.
+		request.Cores = max(newProcs.(float64)-oldProcs.(float64), 0)
+		request.Memory = max(newMem.(float64)-oldMem.(float64), 0)
+	}
+	if request.SysType == "" || (request.Cores == 0 && request.Memory == 0) {
+		return piCapacityRequest{}, false
+	}
+	return request, true
+}
+
+// piVolumeCapacityRequest returns the storage a volume plan adds to the
+// workspace, false when it is not known at plan time.
+func piVolumeCapacityRequest(diff *schema.ResourceDiff) (piCapacityRequest, bool) {
+	if !diff.NewValueKnown(Arg_VolumeName) || !diff.NewValueKnown(Arg_VolumeSize) {
+		return piCapacityRequest{}, false
+	}
+	oldSize, newSize := diff.GetChange(Arg_VolumeSize)
+	request := piCapacityRequest{
+		VolumeSize:    newSize.(float64),
+		VolumeRequest: true,
+		Name:          fmt.Sprintf("volume %s", diff.Get(Arg_VolumeName).(string)),
+	}
+	// Without a pool or type the volume is checked against the whole workspace.
+	if diff.NewValueKnown(Arg_VolumePool) {
+		request.StoragePool = diff.Get(Arg_VolumePool).(string)
+	}
+	if diff.NewValueKnown(Arg_VolumeType) {
+		request.StorageType = diff.Get(Arg_VolumeType).(string)
+	}
+	if diff.Id() != "" {
+		request.VolumeSize = max(newSize.(float64)-oldSize.(float64), 0)
+	}
+	if request.VolumeSize == 0 {
+		return piCapacityRequest{}, false
+	}
+	return request, true
+}
+
+// piCapacityCheckCustomizeDiff records the request of the planned resource and
+// checks the requests of the workspace against its free capacity.
+func piCapacityCheckCustomizeDiff(resource func() *schema.Resource, requestFunc func(*schema.ResourceDiff) (piCapacityRequest, bool)) schema.CustomizeDiffFunc {
+	forceNewKeys := sync.OnceValue(func() []string {
+		keys := []string{}
+		for key, attr := range resource().Schema {
+			if attr.ForceNew {
+				keys = append(keys, key)
+			}
+		}
+		return keys
+	})
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		mode := diff.Get(Arg_CapacityCheck).(string)
+		if mode == "" || !diff.NewValueKnown(Arg_CloudInstanceID) {
+			return nil
+		}
+		cloudInstanceID := diff.Get(Arg_CloudInstanceID).(string)
+		if diff.Id() != "" && diff.HasChanges(forceNewKeys()...) {
+			// The plugin SDK plans a replacement again as a new resource,
+			// which records the whole request.
+			piCapacityForget(cloudInstanceID, diff.Id())
+			return nil
+		}
+		request, ok := requestFunc(diff)
+		if !ok {
+			// A resource that no longer grows leaves the aggregate.
+			piCapacityForget(cloudInstanceID, diff.Id())
+			return nil
+		}
+		key, findings, err := piCapacityRecordAndCheck(ctx, meta, cloudInstanceID, diff.Id(), request)
+		if err != nil {
+			return fmt.Errorf("capacity check of %s failed: %s", request.Name, err)
+		}
+		if len(findings) == 0 {
+			return nil
+		}
+		message := fmt.Sprintf("insufficient capacity for the planned instances and volumes of workspace %s: %s", cloudInstanceID, strings.Join(findings, "; "))
+		if mode == Fail {
+			// The failed plan is not applied.
+			piCapacityForget(cloudInstanceID, key)
+			return fmt.Errorf("%s", message)
+		}
+		log.Printf("[WARN] %s", message)
+		return nil
+	}
+}
+
+// piCapacityCheckWarnings returns the findings of the capacity check as
+// warnings when pi_capacity_check is warn, they are added to the result of
+// create because a plan cannot return warnings.
+func piCapacityCheckWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if d.Get(Arg_CapacityCheck).(string) != Warn {
+		return nil
+	}
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	piCapacityPlans.Lock()
+	defer piCapacityPlans.Unlock()
+	snapshot, ok := piCapacityPlans.snapshots[cloudInstanceID]
+	if !ok {
+		return nil
+	}
+	findings := piCapacityEvaluate(snapshot, piCapacityPlans.requests[cloudInstanceID])
+	if len(findings) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Insufficient capacity in workspace %s", cloudInstanceID),
+		Detail:   strings.Join(findings, "\n"),
+	}}
+}
+
+func piCapacityRecordAndCheck(ctx context.Context, meta interface{}, cloudInstanceID, id string, request piCapacityRequest) (string, []string, error) {
+	piCapacityPlans.Lock()
+	defer piCapacityPlans.Unlock()
+
+	snapshot, ok := piCapacityPlans.snapshots[cloudInstanceID]
+	if !ok {
+		sess, err := meta.(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return "", nil, err
+		}
+		systemPools, err := instance.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID).GetSystemPools()
+		if err != nil {
+			return "", nil, fmt.Errorf("get system pools failed: %s", err)
+		}
+		storagePools, err := instance.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID).GetAllStoragePoolsCapacity()
+		if err != nil {
+			return "", nil, fmt.Errorf("get storage pools capacity failed: %s", err)
+		}
+		snapshot = &piCapacitySnapshot{SystemPools: systemPools, StoragePools: storagePools}
+		piCapacityPlans.snapshots[cloudInstanceID] = snapshot
+	}
+	key := piCapacityRecord(cloudInstanceID, id, request)
+	return key, piCapacityEvaluate(snapshot, piCapacityPlans.requests[cloudInstanceID]), nil
+}
+
+// piCapacityRecord records the request of a resource and returns its key, the
+// caller holds the lock of piCapacityPlans.
+func piCapacityRecord(cloudInstanceID, id string, request piCapacityRequest) string {
+	requests := piCapacityPlans.requests[cloudInstanceID]
+	if requests == nil {
+		requests = map[string]piCapacityRequest{}
+		piCapacityPlans.requests[cloudInstanceID] = requests
+	}
+	if id != "" {
+		requests[id] = request
+		return id
+	}
+	request.BaseName = request.Name
+	for i := 2; ; i++ {
+		if _, ok := requests[request.Name]; !ok {
+			break
+		}
+		request.Name = fmt.Sprintf("%s #%d", request.BaseName, i)
+	}
+	requests[request.Name] = request
+	return request.Name
+}
+
+// piCapacityForget removes a request.
+func piCapacityForget(cloudInstanceID, key string) {
+	if key == "" {
+		return
+	}
+	piCapacityPlans.Lock()
+	defer piCapacityPlans.Unlock()
+	delete(piCapacityPlans.requests[cloudInstanceID], key)
+}
+
+// piCapacityRelease removes the request of an applied resource, created,
+// updated, deleted or failed, and drops the free capacity of its workspace so
+// the next check reads it again. A new resource releases the last request
+// recorded under its name, the requests of a name are interchangeable.
+func piCapacityRelease(d *schema.ResourceData, name string) {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	piCapacityPlans.Lock()
+	defer piCapacityPlans.Unlock()
+	delete(piCapacityPlans.snapshots, cloudInstanceID)
+	requests := piCapacityPlans.requests[cloudInstanceID]
+	if _, ok := requests[d.Id()]; ok {
+		delete(requests, d.Id())
+		return
+	}
+	last := ""
+	for key, request := range requests {
+		if request.BaseName == name && (last == "" || len(key) > len(last) || (len(key) == len(last) && key > last)) {
+			last = key
+		}
+	}
+	delete(requests, last)
+}
+
+// ResetCapacityPlans drops the requests and the free capacity recorded by the
+// capacity check, it is called when the provider is configured for a new plan
+// or apply.
+func ResetCapacityPlans() {
+	piCapacityPlans.Lock()
+	defer piCapacityPlans.Unlock()
+	piCapacityPlans.snapshots = map[string]*piCapacitySnapshot{}
+	piCapacityPlans.requests = map[string]map[string]piCapacityRequest{}
+}
+
+// piCapacityEvaluate checks every instance against the largest host of its
+// system pool, the instances of a system type against the pool, and the
+// volumes against the free capacity of their storage pool, storage type or
+// the workspace.
+func piCapacityEvaluate(snapshot *piCapacitySnapshot, requests map[string]piCapacityRequest) []string {
+	findings := []string{}
+	keys := sortedTotalKeys(requests)
+
+	type total struct {
+		value float64
+		names []string
+	}
+	cores, memory := map[string]*total{}, map[string]*total{}
+	storageByPool, storageByType, storage := map[string]*total{}, map[string]*total{}, &total{}
+	add := func(totals map[string]*total, key string, value float64, name string) {
+		if totals[key] == nil {
+			totals[key] = &total{}
+		}
+		totals[key].value += value
+		totals[key].names = append(totals[key].names, name)
+	}
+
+	for _, key := range keys {
+		request := requests[key]
+		name := request.Name
+		if request.InstanceRequest {
+			pool, ok := snapshot.SystemPools[request.SysType]
+			if !ok {
+				findings = append(findings, fmt.Sprintf("%s: system type %s is not available in the workspace", name, request.SysType))
+				continue
+			}
+			if pool.MaxCoresAvailable != nil && pool.MaxCoresAvailable.Cores != nil && request.InstanceCores > *pool.MaxCoresAvailable.Cores {
+				findings = append(findings, fmt.Sprintf("%s: %g processors exceed the %g cores of the largest %s host", name, request.InstanceCores, *pool.MaxCoresAvailable.Cores, request.SysType))
+			}
+			if pool.MaxMemoryAvailable != nil && pool.MaxMemoryAvailable.Memory != nil && request.InstanceMemory > float64(*pool.MaxMemoryAvailable.Memory) {
+				findings = append(findings, fmt.Sprintf("%s: %g GB memory exceed the %d GB of the largest %s host", name, request.InstanceMemory, *pool.MaxMemoryAvailable.Memory, request.SysType))
+			}
+			add(cores, request.SysType, request.Cores, name)
+			add(memory, request.SysType, request.Memory, name)
+		}
+		if request.VolumeRequest {
+			switch {
+			case request.StoragePool != "":
+				add(storageByPool, request.StoragePool, request.VolumeSize, name)
+			case request.StorageType != "":
+				add(storageByType, request.StorageType, request.VolumeSize, name)
+			default:
+				storage.value += request.VolumeSize
+				storage.names = append(storage.names, name)
+			}
+		}
+	}
+
+	for _, sysType := range sortedTotalKeys(cores) {
+		pool := snapshot.SystemPools[sysType]
+		if pool.MaxAvailable == nil {
+			continue
+		}
+		if pool.MaxAvailable.Cores != nil && cores[sysType].value > *pool.MaxAvailable.Cores {
+			findings = append(findings, fmt.Sprintf("%g processors planned on %s (%s) exceed the %g cores available", cores[sysType].value, sysType, strings.Join(cores[sysType].names, ", "), *pool.MaxAvailable.Cores))
+		}
+		if pool.MaxAvailable.Memory != nil && memory[sysType].value > float64(*pool.MaxAvailable.Memory) {
+			findings = append(findings, fmt.Sprintf("%g GB memory planned on %s (%s) exceed the %d GB available", memory[sysType].value, sysType, strings.Join(memory[sysType].names, ", "), *pool.MaxAvailable.Memory))
+		}
+	}
+
+	if snapshot.StoragePools == nil {
+		return findings
+	}
+	available := func(match func(*models.StoragePoolCapacity) bool) float64 {
+		sum := 0.0
+		for _, sp := range snapshot.StoragePools.StoragePoolsCapacity {
+			if sp != nil && match(sp) {
+				sum += float64(piStoragePoolAvailable(sp))
+			}
+		}
+		return sum
+	}
+	for _, pool := range sortedTotalKeys(storageByPool) {
+		if free := available(func(sp *models.StoragePoolCapacity) bool { return sp.PoolName == pool }); storageByPool[pool].value > free {
+			findings = append(findings, fmt.Sprintf("%g GB of volumes planned in storage pool %s (%s) exceed the %g GB available", storageByPool[pool].value, pool, strings.Join(storageByPool[pool].names, ", "), free))
+		}
+	}
+	for _, storageType := range sortedTotalKeys(storageByType) {
+		if free := available(func(sp *models.StoragePoolCapacity) bool { return sp.StorageType == storageType }); storageByType[storageType].value > free {
+			findings = append(findings, fmt.Sprintf("%g GB of volumes planned with storage type %s (%s) exceed the %g GB available", storageByType[storageType].value, storageType, strings.Join(storageByType[storageType].names, ", "), free))
+		}
+	}
+	if storage.value > 0 {
+		if free := available(func(*models.StoragePoolCapacity) bool { return true }); storage.value > free {
+			findings = append(findings, fmt.Sprintf("%g GB of volumes planned (%s) exceed the %g GB available in the workspace", storage.value, strings.Join(storage.names, ", "), free))
+		}
+	}
+	return findings
+}
+
+// piStoragePoolAvailable returns the free capacity of a storage pool in GB,
+// older workspaces only report the maximum allocation size.
+func piStoragePoolAvailable(sp *models.StoragePoolCapacity) int64 {
+	if sp.AvailableCapacity > 0 {
+		return sp.AvailableCapacity
+	}
+	if sp.MaxAllocationSize != nil {
+		return *sp.MaxAllocationSize
+	}
+	return 0
+}
+
+func sortedTotalKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
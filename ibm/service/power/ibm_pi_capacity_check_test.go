@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testPICapacitySnapshot() *piCapacitySnapshot {
+	return &piCapacitySnapshot{
+		SystemPools: models.SystemPools{
+			"s922": models.SystemPool{
+				MaxAvailable:       &models.System{Cores: core.Float64Ptr(8), Memory: core.Int64Ptr(256)},
+				MaxCoresAvailable:  &models.System{Cores: core.Float64Ptr(4), Memory: core.Int64Ptr(128)},
+				MaxMemoryAvailable: &models.System{Cores: core.Float64Ptr(4), Memory: core.Int64Ptr(128)},
+			},
+		},
+		StoragePools: &models.StoragePoolsCapacity{
+			StoragePoolsCapacity: []*models.StoragePoolCapacity{
+				{PoolName: "Tier1-Flash-1", StorageType: "tier1", AvailableCapacity: 100},
+				{PoolName: "Tier3-Flash-1", StorageType: "tier3", MaxAllocationSize: core.Int64Ptr(50)},
+			},
+		},
+	}
+}
+
+func TestPICapacityEvaluate(t *testing.T) {
+	instance := func(name string, cores, memory, replicants float64) piCapacityRequest {
+		return piCapacityRequest{
+			SysType:         "s922",
+			Cores:           cores * replicants,
+			Memory:          memory * replicants,
+			InstanceCores:   cores,
+			InstanceMemory:  memory,
+			InstanceRequest: true,
+			Name:            name,
+		}
+	}
+	volume := func(name, pool, storageType string, size float64) piCapacityRequest {
+		return piCapacityRequest{StoragePool: pool, StorageType: storageType, VolumeSize: size, VolumeRequest: true, Name: name}
+	}
+
+	tests := []struct {
+		name     string
+		requests map[string]piCapacityRequest
+		want     []string
+	}{
+		{
+			name: "Requests within the free capacity",
+			requests: map[string]piCapacityRequest{
+				"instance a": instance("instance a", 2, 64, 2),
+				"volume a":   volume("volume a", "Tier1-Flash-1", "", 100),
+				"volume b":   volume("volume b", "", "tier3", 50),
+			},
+			want: []string{},
+		},
+		{
+			name: "Instance larger than the largest host",
+			requests: map[string]piCapacityRequest{
+				"instance a": instance("instance a", 6, 192, 1),
+			},
+			want: []string{
+				"instance a: 6 processors exceed the 4 cores of the largest s922 host",
+				"instance a: 192 GB memory exceed the 128 GB of the largest s922 host",
+			},
+		},
+		{
+			name: "Unknown system type",
+			requests: map[string]piCapacityRequest{
+				"instance a": {SysType: "e980", Cores: 1, Memory: 2, InstanceCores: 1, InstanceMemory: 2, InstanceRequest: true, Name: "instance a"},
+			},
+			want: []string{"instance a: system type e980 is not available in the workspace"},
+		},
+		{
+			name: "Replicants exceed the system pool",
+			requests: map[string]piCapacityRequest{
+				"instance a": instance("instance a", 3, 32, 3),
+			},
+			want: []string{"9 processors planned on s922 (instance a) exceed the 8 cores available"},
+		},
+		{
+			name: "Volumes with the same name are added up",
+			requests: map[string]piCapacityRequest{
+				"volume data":    volume("volume data", "Tier1-Flash-1", "", 60),
+				"volume data #2": volume("volume data #2", "Tier1-Flash-1", "", 60),
+			},
+			want: []string{"120 GB of volumes planned in storage pool Tier1-Flash-1 (volume data, volume data #2) exceed the 100 GB available"},
+		},
+		{
+			name: "Volumes by storage type and workspace",
+			requests: map[string]piCapacityRequest{
+				"volume a": volume("volume a", "", "tier3", 60),
+				"volume b": volume("volume b", "", "", 200),
+			},
+			want: []string{
+				"60 GB of volumes planned with storage type tier3 (volume a) exceed the 50 GB available",
+				"200 GB of volumes planned (volume b) exceed the 150 GB available in the workspace",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := piCapacityEvaluate(testPICapacitySnapshot(), tt.requests)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("piCapacityEvaluate() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPICapacityRecord(t *testing.T) {
+	const workspace = "test-workspace"
+	defer delete(piCapacityPlans.requests, workspace)
+
+	volume := piCapacityRequest{VolumeSize: 10, VolumeRequest: true, Name: "volume data"}
+	piCapacityRecord(workspace, "", volume)
+	piCapacityRecord(workspace, "", volume)
+	piCapacityRecord(workspace, "test-workspace/volume-id", volume)
+	volume.VolumeSize = 20
+	piCapacityRecord(workspace, "test-workspace/volume-id", volume)
+
+	requests := piCapacityPlans.requests[workspace]
+	want := map[string]float64{
+		"volume data":              10,
+		"volume data #2":           10,
+		"test-workspace/volume-id": 20,
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("piCapacityRecord() recorded %d requests, want %d", len(requests), len(want))
+	}
+	for key, size := range want {
+		if requests[key].VolumeSize != size {
+			t.Errorf("piCapacityRecord() request %s got %g GB, want %g GB", key, requests[key].VolumeSize, size)
+		}
+	}
+	if requests["volume data #2"].Name != "volume data #2" {
+		t.Errorf("piCapacityRecord() second request named %s, want volume data #2", requests["volume data #2"].Name)
+	}
+
+	piCapacityForget(workspace, "test-workspace/volume-id")
+	if _, ok := piCapacityPlans.requests[workspace]["test-workspace/volume-id"]; ok {
+		t.Errorf("piCapacityForget() kept the request of test-workspace/volume-id")
+	}
+}
+
+func TestPICapacityRelease(t *testing.T) {
+	const workspace = "test-workspace"
+	defer ResetCapacityPlans()
+	ResetCapacityPlans()
+
+	volume := piCapacityRequest{VolumeSize: 10, VolumeRequest: true, Name: "volume data"}
+	piCapacityPlans.Lock()
+	piCapacityPlans.snapshots[workspace] = testPICapacitySnapshot()
+	piCapacityRecord(workspace, "", volume)
+	piCapacityRecord(workspace, "", volume)
+	piCapacityRecord(workspace, "test-workspace/volume-id", volume)
+	piCapacityPlans.Unlock()
+
+	d := schema.TestResourceDataRaw(t, ResourceIBMPIVolume().Schema, map[string]interface{}{
+		Arg_CloudInstanceID: workspace,
+		Arg_VolumeName:      "data",
+		Arg_VolumeSize:      10,
+	})
+	d.SetId("test-workspace/new-volume-id")
+	piCapacityRelease(d, "volume data")
+	if _, ok := piCapacityPlans.snapshots[workspace]; ok {
+		t.Errorf("piCapacityRelease() kept the free capacity of the workspace")
+	}
+	if _, ok := piCapacityPlans.requests[workspace]["volume data #2"]; ok {
+		t.Errorf("piCapacityRelease() kept the last request of volume data")
+	}
+	if _, ok := piCapacityPlans.requests[workspace]["volume data"]; !ok {
+		t.Errorf("piCapacityRelease() removed the first request of volume data")
+	}
+
+	d.SetId("test-workspace/volume-id")
+	piCapacityRelease(d, "volume data")
+	if len(piCapacityPlans.requests[workspace]) != 1 {
+		t.Errorf("piCapacityRelease() of test-workspace/volume-id left %d requests, want 1", len(piCapacityPlans.requests[workspace]))
+	}
+
+	ResetCapacityPlans()
+	if len(piCapacityPlans.requests) != 0 || len(piCapacityPlans.snapshots) != 0 {
+		t.Errorf("ResetCapacityPlans() kept requests or snapshots")
+	}
+}
@@ -5,6 +5,7 @@ import "time"
 const (
 	// Arguments
 	Arg_Action                               = "pi_action"
+	Arg_ActiveSite                           = "pi_active_site"
 	Arg_Advertise                            = "pi_advertise"
 	Arg_AffinityInstance                     = "pi_affinity_instance"
 	Arg_AffinityPolicy                       = "pi_affinity_policy"
@@ -15,6 +16,7 @@ const (
 	Arg_AuxiliaryVolumeName                  = "pi_auxiliary_volume_name"
 	Arg_AuxiliaryVolumes                     = "pi_auxiliary_volumes"
 	Arg_BootVolumeReplicationEnabled         = "pi_boot_volume_replication_enabled"
+	Arg_CapacityCheck                        = "pi_capacity_check"
 	Arg_CaptureCloudStorageAccessKey         = "pi_capture_cloud_storage_access_key"
 	Arg_CaptureCloudStorageRegion            = "pi_capture_cloud_storage_region"
 	Arg_CaptureCloudStorageSecretKey         = "pi_capture_cloud_storage_secret_key"
@@ -22,7 +24,9 @@ const (
 	Arg_CaptureName                          = "pi_capture_name"
 	Arg_CaptureStorageImagePath              = "pi_capture_storage_image_path"
 	Arg_CaptureVolumeIDs                     = "pi_capture_volume_ids"
+	Arg_CatalogImageIDs                      = "pi_catalog_image_ids"
 	Arg_Cidr                                 = "pi_cidr"
+	Arg_CloudConnection                      = "pi_cloud_connection"
 	Arg_CloudConnectionClassicEnabled        = "pi_cloud_connection_classic_enabled"
 	Arg_CloudConnectionGlobalRouting         = "pi_cloud_connection_global_routing"
 	Arg_CloudConnectionGreCidr               = "pi_cloud_connection_gre_cidr"
@@ -50,6 +54,7 @@ const (
 	Arg_DestinationPort                      = "pi_destination_port"
 	Arg_DestinationPorts                     = "pi_destination_ports"
 	Arg_DestinationType                      = "pi_destination_type"
+	Arg_Dhcp                                 = "pi_dhcp"
 	Arg_DhcpID                               = "pi_dhcp_id"
 	Arg_DhcpName                             = "pi_dhcp_name"
 	Arg_DhcpSnatEnabled                      = "pi_dhcp_snat_enabled"
@@ -107,6 +112,7 @@ const (
 	Arg_NetworkPeerID                        = "pi_network_peer_id"
 	Arg_NetworkPortDescription               = "pi_network_port_description"
 	Arg_NetworkPortIPAddress                 = "pi_network_port_ipaddress"
+	Arg_Networks                             = "pi_networks"
 	Arg_NetworkSecurityGroupID               = "pi_network_security_group_id"
 	Arg_NetworkSecurityGroupMemberID         = "pi_network_security_group_member_id"
 	Arg_NetworkSecurityGroupRuleID           = "pi_network_security_group_rule_id"
@@ -124,6 +130,7 @@ const (
 	Arg_PreferredProcessorCompatibilityMode  = "pi_preferred_processor_compatibility_mode"
 	Arg_Prefix                               = "pi_prefix"
 	Arg_PrefixFilter                         = "pi_prefix_filter"
+	Arg_PrimaryCloudInstanceID               = "pi_primary_cloud_instance_id"
 	Arg_Processors                           = "pi_processors"
 	Arg_ProcType                             = "pi_proc_type"
 	Arg_Protocol                             = "pi_protocol"
@@ -139,10 +146,13 @@ const (
 	Arg_RetainVirtualSerialNumber            = "pi_retain_virtual_serial_number"
 	Arg_RouteFilterID                        = "pi_route_filter_id"
 	Arg_RouteID                              = "pi_route_id"
+	Arg_Rules                                = "pi_rules"
 	Arg_SAP                                  = "sap"
 	Arg_SAPDeploymentType                    = "pi_sap_deployment_type"
 	Arg_SAPProfileID                         = "pi_sap_profile_id"
 	Arg_Secondaries                          = "pi_secondaries"
+	Arg_SecondaryCloudInstanceID             = "pi_secondary_cloud_instance_id"
+	Arg_SecondaryZone                        = "pi_secondary_zone"
 	Arg_Serial                               = "pi_serial"
 	Arg_SharedProcessorPool                  = "pi_shared_processor_pool"
 	Arg_SharedProcessorPoolHostGroup         = "pi_shared_processor_pool_host_group"
@@ -163,6 +173,7 @@ const (
 	Arg_SPPPlacementGroupPolicy              = "pi_spp_placement_group_policy"
 	Arg_SSHKey                               = "pi_ssh_key"
 	Arg_SSHKeyID                             = "pi_ssh_key_id"
+	Arg_SSHKeys                              = "pi_ssh_keys"
 	Arg_StartingIPAddress                    = "pi_starting_ip_address"
 	Arg_StorageConnection                    = "pi_storage_connection"
 	Arg_StoragePool                          = "pi_storage_pool"
@@ -171,6 +182,7 @@ const (
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
+	Arg_TransitGatewayID                     = "pi_transit_gateway_id"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
 	Arg_UserTags                             = "pi_user_tags"
@@ -182,12 +194,14 @@ const (
 	Arg_Volume                               = "pi_volume"
 	Arg_VolumeCloneName                      = "pi_volume_clone_name"
 	Arg_VolumeCloneTaskID                    = "pi_volume_clone_task_id"
+	Arg_VolumeCount                          = "pi_volume_count"
 	Arg_VolumeGroupAction                    = "pi_volume_group_action"
 	Arg_VolumeGroupID                        = "pi_volume_group_id"
 	Arg_VolumeGroupName                      = "pi_volume_group_name"
 	Arg_VolumeID                             = "pi_volume_id"
 	Arg_VolumeIDs                            = "pi_volume_ids"
 	Arg_VolumeName                           = "pi_volume_name"
+	Arg_VolumeNameTemplate                   = "pi_volume_name_template"
 	Arg_VolumeOnboardingID                   = "pi_volume_onboarding_id"
 	Arg_VolumePool                           = "pi_volume_pool"
 	Arg_VolumeShareable                      = "pi_volume_shareable"
@@ -208,8 +222,10 @@ const (
 	Attr_ARPBroadcast                        = "arp_broadcast"
 	Attr_Asaps                               = "asaps"
 	Attr_AsynchronousReplication             = "asynchronous_replication"
+	Attr_Attached                            = "attached"
 	Attr_Auxiliary                           = "auxiliary"
 	Attr_AuxiliaryChangedVolumeName          = "auxiliary_changed_volume_name"
+	Attr_AuxiliaryVolumeIDs                  = "auxiliary_volume_ids"
 	Attr_AuxiliaryVolumeName                 = "auxiliary_volume_name"
 	Attr_AvailabilityZone                    = "availability_zone"
 	Attr_AvailableCores                      = "available_cores"
@@ -234,6 +250,7 @@ const (
 	Attr_Connections                         = "connections"
 	Attr_ConsistencyGroupName                = "consistency_group_name"
 	Attr_ConsoleLanguages                    = "console_languages"
+	Attr_ConsoleURL                          = "console_url"
 	Attr_ContainerFormat                     = "container_format"
 	Attr_CopyRate                            = "copy_rate"
 	Attr_CopyType                            = "copy_type"
@@ -286,6 +303,7 @@ const (
 	Attr_DiskType                            = "disk_type"
 	Attr_DisplayName                         = "display_name"
 	Attr_DNS                                 = "dns"
+	Attr_DNSServer                           = "dns_server"
 	Attr_EffectiveProcessorCompatibilityMode = "effective_processor_compatibility_mode"
 	Attr_Enabled                             = "enabled"
 	Attr_Endianness                          = "endianness"
@@ -308,6 +326,8 @@ const (
 	Attr_GreDestinationAddress               = "gre_destination_address"
 	Attr_GreSourceAddress                    = "gre_source_address"
 	Attr_GroupID                             = "group_id"
+	Attr_HealthLastUpdate                    = "health_last_update"
+	Attr_HealthReason                        = "health_reason"
 	Attr_HealthStatus                        = "health_status"
 	Attr_HostGroup                           = "host_group"
 	Attr_HostGroupID                         = "host_group_id"
@@ -328,9 +348,11 @@ const (
 	Attr_ICMPType                            = "icmp_type"
 	Attr_ID                                  = "id"
 	Attr_ImageID                             = "image_id"
+	Attr_ImageIDs                            = "image_ids"
 	Attr_ImageInfo                           = "image_info"
 	Attr_Images                              = "images"
 	Attr_ImageType                           = "image_type"
+	Attr_ImportJob                           = "import_job"
 	Attr_ImportRouteFilters                  = "import_route_filters"
 	Attr_Index                               = "index"
 	Attr_InputVolumes                        = "input_volumes"
@@ -398,6 +420,7 @@ const (
 	Attr_NetworkAddressGroups                = "network_address_groups"
 	Attr_NetworkAddressTranslation           = "network_address_translation"
 	Attr_NetworkID                           = "network_id"
+	Attr_NetworkIDs                          = "network_ids"
 	Attr_NetworkInterfaceID                  = "network_interface_id"
 	Attr_NetworkName                         = "network_name"
 	Attr_NetworkPeers                        = "network_peers"
@@ -421,6 +444,7 @@ const (
 	Attr_PeerInterfaceID                     = "peer_interface_id"
 	Attr_PeerInterfaces                      = "peer_interfaces"
 	Attr_PeerType                            = "peer_type"
+	Attr_PendingSteps                        = "pending_steps"
 	Attr_PercentComplete                     = "percent_complete"
 	Attr_PIInstanceSharedProcessorPool       = "shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPoolID     = "shared_processor_pool_id"
@@ -438,6 +462,7 @@ const (
 	Attr_Prefix                              = "prefix"
 	Attr_Primary                             = "primary"
 	Attr_PrimaryRole                         = "primary_role"
+	Attr_PrimaryVolumeGroupID                = "primary_volume_group_id"
 	Attr_PrimaryWorkspace                    = "primary_workspace"
 	Attr_Processors                          = "processors"
 	Attr_ProcType                            = "proctype"
@@ -475,6 +500,7 @@ const (
 	Attr_Rules                               = "rules"
 	Attr_SAPS                                = "saps"
 	Attr_Secondaries                         = "secondaries"
+	Attr_SecondaryVolumeGroupID              = "secondary_volume_group_id"
 	Attr_Serial                              = "serial"
 	Attr_ServerName                          = "server_name"
 	Attr_Servers                             = "servers"
@@ -486,6 +512,7 @@ const (
 	Attr_SharedProcessorPools                = "shared_processor_pools"
 	Attr_Size                                = "size"
 	Attr_SnapshotID                          = "snapshot_id"
+	Attr_SnatEnabled                         = "snat_enabled"
 	Attr_SoftwareTier                        = "software_tier"
 	Attr_Source                              = "source"
 	Attr_SourceChecksum                      = "source_checksum"
@@ -501,6 +528,7 @@ const (
 	Attr_SPPPlacementGroups                  = "spp_placement_groups"
 	Attr_SSHKey                              = "ssh_key"
 	Attr_SSHKeyID                            = "ssh_key_id"
+	Attr_SSHKeyIDs                           = "ssh_key_ids"
 	Attr_Start                               = "start"
 	Attr_StartTime                           = "start_time"
 	Attr_State                               = "state"
@@ -538,6 +566,8 @@ const (
 	Attr_TotalProcessorsConsumed             = "total_processors_consumed"
 	Attr_TotalSSDStorageConsumed             = "total_ssd_storage_consumed"
 	Attr_TotalStandardStorageConsumed        = "total_standard_storage_consumed"
+	Attr_TransitEnabled                      = "transit_enabled"
+	Attr_TransitGatewayConnectionID          = "transit_gateway_connection_id"
 	Attr_Type                                = "type"
 	Attr_Uncapped                            = "uncapped"
 	Attr_UpdatedAt                           = "updated_at"
@@ -623,11 +653,13 @@ const (
 	EchoReply                  = "echo-reply"
 	Enable                     = "enable"
 	Export                     = "export"
+	Fail                       = "fail"
 	Hana                       = "Hana"
 	Hard                       = "hard"
 	Host                       = "host"
 	HostGroup                  = "hostGroup"
 	ICMP                       = "icmp"
+	Idling                     = "idling"
 	ImageCatalog               = "image-catalog"
 	Import                     = "import"
 	Internal_Only              = "internal-only"
@@ -646,10 +678,12 @@ const (
 	Outbound_Only              = "outbound-only"
 	PER                        = "power-edge-router"
 	Prefix                     = "prefix"
+	Primary                    = "primary"
 	Private                    = "private"
 	Public                     = "public"
 	PubVlan                    = "pub-vlan"
 	SAP                        = "SAP"
+	Secondary                  = "secondary"
 	Shared                     = "shared"
 	Soft                       = "soft"
 	SourceQuench               = "source-quench"
@@ -661,6 +695,7 @@ const (
 	UserTagType                = "user"
 	Vlan                       = "vlan"
 	vSCSI                      = "vSCSI"
+	Warn                       = "warn"
 	Warning                    = "WARNING"
 	Workspace                  = "workspace"
 
@@ -675,10 +710,12 @@ const (
 
 	// States
 	NotFound                 = "not found"
+	State_Accepted           = "accepted"
 	State_Active             = "active"
 	State_ACTIVE             = "ACTIVE"
 	State_Added              = "added"
 	State_Adding             = "adding"
+	State_Attached           = "attached"
 	State_Available          = "available"
 	State_Build              = "build"
 	State_Building           = "building"
@@ -694,6 +731,7 @@ const (
 	State_Failed             = "failed"
 	State_Found              = "Found"
 	State_Inactive           = "inactive"
+	State_Incomplete         = "incomplete"
 	State_InProgress         = "in progress"
 	State_inProgress         = "inProgress"
 	State_InUse              = "in-use"
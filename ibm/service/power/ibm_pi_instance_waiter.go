@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// piInstanceStateFunc returns the state of the wait for the last observed
+// instance. Returning an error stops the wait.
+type piInstanceStateFunc func(pvm *models.PVMInstance) (string, error)
+
+// piInstanceWaitConf is the configuration of waitForPIInstanceState.
+type piInstanceWaitConf struct {
+	Pending    []string
+	Target     []string
+	State      piInstanceStateFunc
+	Delay      time.Duration // Defaults to Timeout_Delay
+	MinTimeout time.Duration // Defaults to Timeout_Active
+	Timeout    time.Duration
+
+	// NotFound is the state reported when the instance cannot be retrieved.
+	// When empty, failing to retrieve the instance stops the wait.
+	NotFound string
+}
+
+// piInstanceTransition is a change of status or health observed while waiting.
+type piInstanceTransition struct {
+	observed time.Time
+	status   string
+	health   string
+}
+
+// piInstanceObservations is the last observed instance and the transitions
+// observed while waiting.
+type piInstanceObservations struct {
+	last        *models.PVMInstance
+	transitions []piInstanceTransition
+}
+
+type piPollIntervalKey struct{}
+
+// contextWithPIPollInterval returns a context carrying the poll interval of
+// the provider, used by waitForPIInstanceState.
+func contextWithPIPollInterval(ctx context.Context, meta any) context.Context {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok || sess.PIPollInterval() <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, piPollIntervalKey{}, sess.PIPollInterval())
+}
+
+func piPollInterval(ctx context.Context) time.Duration {
+	interval, _ := ctx.Value(piPollIntervalKey{}).(time.Duration)
+	return interval
+}
+
+// waitForPIInstanceState polls the instance until conf.State reports one of
+// the target states. On error or timeout, the returned error describes the
+// last observed status, health and fault of the instance, together with the
+// status transitions observed during the wait.
+func waitForPIInstanceState(ctx context.Context, client *instance.IBMPIInstanceClient, id string, conf piInstanceWaitConf) (any, error) {
+	// The wait returns when the context is done while the refresh may still
+	// run, so the observations are swapped atomically.
+	var observed atomic.Pointer[piInstanceObservations]
+	observed.Store(&piInstanceObservations{})
+
+	stateConf := &retry.StateChangeConf{
+		Pending: conf.Pending,
+		Target:  conf.Target,
+		Refresh: func() (any, string, error) {
+			pvm, err := client.Get(id)
+			if err != nil {
+				if conf.NotFound != "" {
+					log.Printf("[DEBUG] The pvm instance %s does not exist: %s", id, err)
+					return pvm, conf.NotFound, nil
+				}
+				return nil, "", err
+			}
+			observed.Store(&piInstanceObservations{
+				last:        pvm,
+				transitions: recordPIInstanceTransition(observed.Load().transitions, pvm),
+			})
+			state, err := conf.State(pvm)
+			return pvm, state, err
+		},
+		Delay:        conf.Delay,
+		MinTimeout:   conf.MinTimeout,
+		PollInterval: piPollInterval(ctx),
+		Timeout:      conf.Timeout,
+	}
+	if stateConf.Delay == 0 {
+		stateConf.Delay = Timeout_Delay
+	}
+	if stateConf.MinTimeout == 0 {
+		stateConf.MinTimeout = Timeout_Active
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		last := observed.Load()
+		return result, fmt.Errorf("%w%s", err, piInstanceWaitDiagnostics(last.last, last.transitions))
+	}
+	return result, nil
+}
+
+// recordPIInstanceTransition returns the transitions with the status and
+// health of pvm appended when they differ from the last recorded transition.
+// The transitions passed in are not modified.
+func recordPIInstanceTransition(transitions []piInstanceTransition, pvm *models.PVMInstance) []piInstanceTransition {
+	t := piInstanceTransition{
+		observed: time.Now().UTC(),
+		status:   piInstanceStatus(pvm),
+	}
+	if pvm.Health != nil {
+		t.health = pvm.Health.Status
+	}
+	if n := len(transitions); n > 0 && transitions[n-1].status == t.status && transitions[n-1].health == t.health {
+		return transitions
+	}
+	return append(transitions[:len(transitions):len(transitions)], t)
+}
+
+// piInstanceWaitDiagnostics describes the last observed instance and the
+// transitions, to be appended to the error of a failed wait.
+func piInstanceWaitDiagnostics(pvm *models.PVMInstance, transitions []piInstanceTransition) string {
+	if pvm == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n\nLast status: %s", piInstanceStatus(pvm))
+	if pvm.Health != nil {
+		fmt.Fprintf(&b, "\nHealth: %s", pvm.Health.Status)
+		if pvm.Health.Reason != "" {
+			fmt.Fprintf(&b, "\nHealth reason: %s", pvm.Health.Reason)
+		}
+	}
+	if pvm.Fault != nil {
+		fmt.Fprintf(&b, "\nFault code: %s", strconv.FormatFloat(pvm.Fault.Code, 'f', -1, 64))
+		fmt.Fprintf(&b, "\nFault message: %s", pvm.Fault.Message)
+		if pvm.Fault.Details != "" {
+			fmt.Fprintf(&b, "\nFault details: %s", pvm.Fault.Details)
+		}
+	}
+	if len(transitions) > 0 {
+		b.WriteString("\nObserved transitions:")
+		for _, t := range transitions {
+			fmt.Fprintf(&b, "\n  %s status: %s", t.observed.Format(time.RFC3339), t.status)
+			if t.health != "" {
+				fmt.Fprintf(&b, ", health: %s", t.health)
+			}
+		}
+	}
+	return b.String()
+}
+
+func piInstanceStatus(pvm *models.PVMInstance) string {
+	if pvm.Status == nil {
+		return ""
+	}
+	return *pvm.Status
+}
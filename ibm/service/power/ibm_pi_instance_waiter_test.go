@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func testPIInstance(status, health string) *models.PVMInstance {
+	pvm := &models.PVMInstance{Status: core.StringPtr(status)}
+	if health != "" {
+		pvm.Health = &models.PVMInstanceHealth{Status: health}
+	}
+	return pvm
+}
+
+func TestRecordPIInstanceTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		observed []*models.PVMInstance
+		want     []string
+	}{
+		{
+			name:     "First observation is recorded",
+			observed: []*models.PVMInstance{testPIInstance("BUILD", "")},
+			want:     []string{"BUILD/"},
+		},
+		{
+			name:     "Unchanged status and health are recorded once",
+			observed: []*models.PVMInstance{testPIInstance("BUILD", "PENDING"), testPIInstance("BUILD", "PENDING"), testPIInstance("ACTIVE", "PENDING")},
+			want:     []string{"BUILD/PENDING", "ACTIVE/PENDING"},
+		},
+		{
+			name:     "Health change is recorded",
+			observed: []*models.PVMInstance{testPIInstance("ACTIVE", "PENDING"), testPIInstance("ACTIVE", "OK")},
+			want:     []string{"ACTIVE/PENDING", "ACTIVE/OK"},
+		},
+		{
+			name:     "Instance without status",
+			observed: []*models.PVMInstance{{}},
+			want:     []string{"/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transitions []piInstanceTransition
+			for _, pvm := range tt.observed {
+				transitions = recordPIInstanceTransition(transitions, pvm)
+			}
+			got := []string{}
+			for _, transition := range transitions {
+				got = append(got, transition.status+"/"+transition.health)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("recordPIInstanceTransition() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// A recorded snapshot is not changed by a later transition.
+	transitions := make([]piInstanceTransition, 1, 2)
+	transitions[0] = piInstanceTransition{status: "BUILD"}
+	first := recordPIInstanceTransition(transitions, testPIInstance("ACTIVE", ""))
+	second := recordPIInstanceTransition(transitions, testPIInstance("ERROR", ""))
+	if first[1].status != "ACTIVE" || second[1].status != "ERROR" {
+		t.Errorf("recordPIInstanceTransition() shares the transitions, got %s and %s", first[1].status, second[1].status)
+	}
+}
+
+func TestPIInstanceWaitDiagnostics(t *testing.T) {
+	observed := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	failed := testPIInstance("ERROR", "CRITICAL")
+	failed.Health.Reason = "host failure"
+	failed.Fault = &models.PVMInstanceFault{Code: 500, Message: "deploy failed", Details: "no capacity"}
+
+	tests := []struct {
+		name        string
+		pvm         *models.PVMInstance
+		transitions []piInstanceTransition
+		want        string
+	}{
+		{
+			name: "No instance observed",
+			want: "",
+		},
+		{
+			name: "Status only",
+			pvm:  testPIInstance("BUILD", ""),
+			want: "\n\nLast status: BUILD",
+		},
+		{
+			name: "Health, fault and transitions",
+			pvm:  failed,
+			transitions: []piInstanceTransition{
+				{observed: observed, status: "BUILD"},
+				{observed: observed, status: "ERROR", health: "CRITICAL"},
+			},
+			want: "\n\nLast status: ERROR" +
+				"\nHealth: CRITICAL" +
+				"\nHealth reason: host failure" +
+				"\nFault code: 500" +
+				"\nFault message: deploy failed" +
+				"\nFault details: no capacity" +
+				"\nObserved transitions:" +
+				"\n  2025-01-02T03:04:05Z status: BUILD" +
+				"\n  2025-01-02T03:04:05Z status: ERROR, health: CRITICAL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := piInstanceWaitDiagnostics(tt.pvm, tt.transitions); got != tt.want {
+				t.Errorf("piInstanceWaitDiagnostics() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,733 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func ResourceIBMPIDRPair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIDRPairCreate,
+		ReadContext:   resourceIBMPIDRPairRead,
+		UpdateContext: resourceIBMPIDRPairUpdate,
+		DeleteContext: resourceIBMPIDRPairDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMPIDRPairCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_ActiveSite: {
+				Default:      Primary,
+				Description:  "The site that serves the volumes, changing it from primary to secondary fails over and from secondary to primary fails back.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Primary, Secondary}),
+			},
+			Arg_PrimaryCloudInstanceID: {
+				Description:  "The GUID of the primary workspace that owns the replication enabled volumes.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SecondaryCloudInstanceID: {
+				Description:  "The GUID of the secondary workspace that holds the auxiliary volumes.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SecondaryZone: {
+				Description: "The zone of the secondary workspace, defaults to the zone of the provider.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_VolumeGroupName: {
+				Description:  "The name of the volume group to create in the primary workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeIDs: {
+				Description: "The replication enabled volumes of the primary workspace to pair.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				MinItems:    1,
+				Required:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_AuxiliaryVolumeIDs: {
+				Computed:    true,
+				Description: "The onboarded auxiliary volumes of the secondary workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_ConsistencyGroupName: {
+				Computed:    true,
+				Description: "The name of the consistency group at storage controller level shared by both volume groups.",
+				Type:        schema.TypeString,
+			},
+			Attr_OnboardingID: {
+				Computed:    true,
+				Description: "The ID of the volume onboarding operation in the secondary workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_PrimaryVolumeGroupID: {
+				Computed:    true,
+				Description: "The ID of the volume group in the primary workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_RemoteCopyRelationships: {
+				Computed:    true,
+				Description: "The remote copy relationships of the volume group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_AuxiliaryVolumeName: {
+							Computed:    true,
+							Description: "The name of the auxiliary volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_MasterVolumeName: {
+							Computed:    true,
+							Description: "The name of the master volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The remote copy relationship name.",
+							Type:        schema.TypeString,
+						},
+						Attr_PrimaryRole: {
+							Computed:    true,
+							Description: "Indicates whether master/aux volume is playing the primary role.",
+							Type:        schema.TypeString,
+						},
+						Attr_Progress: {
+							Computed:    true,
+							Description: "The relationship progress.",
+							Type:        schema.TypeInt,
+						},
+						Attr_State: {
+							Computed:    true,
+							Description: "The relationship state.",
+							Type:        schema.TypeString,
+						},
+						Attr_Synchronized: {
+							Computed:    true,
+							Description: "Indicates whether the relationship is synchronized.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_ReplicationStatus: {
+				Computed:    true,
+				Description: "The replication status of the volume group in the primary workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_SecondaryVolumeGroupID: {
+				Computed:    true,
+				Description: "The ID of the volume group in the secondary workspace.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIDRPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secondarySess, err := piSessionForZone(sess, d.Get(Arg_SecondaryZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryID := d.Get(Arg_PrimaryCloudInstanceID).(string)
+	secondaryID := d.Get(Arg_SecondaryCloudInstanceID).(string)
+	volumeIDs := flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List())
+
+	auxVolumes, sourceCRN, err := drPairAuxiliaryVolumes(ctx, sess, primaryID, volumeIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, primaryID)
+	primaryVG, err := primaryClient.CreateVolumeGroup(&models.VolumeGroupCreate{
+		Name:      d.Get(Arg_VolumeGroupName).(string),
+		VolumeIDs: volumeIDs,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The ID is provisional until the secondary volume group is created, so
+	// that the primary volume group and the onboarding are tracked if a later
+	// step fails.
+	d.SetId(fmt.Sprintf("%s/%s/%s/", primaryID, *primaryVG.ID, secondaryID))
+	d.Set(Attr_PrimaryVolumeGroupID, *primaryVG.ID)
+
+	read := func() diag.Diagnostics {
+		return resourceIBMPIDRPairRead(ctx, d, meta)
+	}
+	err = pairIBMPIDRPair(ctx, d, sess, secondarySess, auxVolumes, sourceCRN, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		d.Set(Arg_ActiveSite, Primary)
+		return flex.PartialCreateDiagnostics(drPairIncompleteDiagnostic(d, err), read)
+	}
+
+	if d.Get(Arg_ActiveSite).(string) == Secondary {
+		secondaryClient := instance.NewIBMPIVolumeGroupClient(ctx, secondarySess, secondaryID)
+		err = drPairFailover(ctx, secondaryClient, d.Get(Attr_SecondaryVolumeGroupID).(string), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			d.Set(Arg_ActiveSite, Primary)
+			return flex.PartialCreateDiagnostics(drPairIncompleteDiagnostic(d, fmt.Errorf("failover to the secondary workspace failed: %w", err)), read)
+		}
+	}
+
+	return read()
+}
+
+func resourceIBMPIDRPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secondarySess, err := piSessionForZone(sess, d.Get(Arg_SecondaryZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryID, primaryVGID, secondaryID, secondaryVGID, err := splitDRPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, primaryID)
+	primaryVG, err := primaryClient.GetDetails(primaryVGID)
+	if err != nil {
+		if strings.Contains(err.Error(), NotFound) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_PrimaryCloudInstanceID, primaryID)
+	d.Set(Arg_SecondaryCloudInstanceID, secondaryID)
+	d.Set(Arg_VolumeGroupName, primaryVG.Name)
+	d.Set(Arg_VolumeIDs, primaryVG.VolumeIDs)
+	d.Set(Attr_ConsistencyGroupName, primaryVG.ConsistencyGroupName)
+	d.Set(Attr_PrimaryVolumeGroupID, primaryVGID)
+	d.Set(Attr_ReplicationStatus, primaryVG.ReplicationStatus)
+	d.Set(Attr_SecondaryVolumeGroupID, secondaryVGID)
+
+	// The secondary volume group is not created yet when the pairing is
+	// incomplete
+	if secondaryVGID == "" {
+		d.Set(Arg_ActiveSite, Primary)
+		d.Set(Attr_AuxiliaryVolumeIDs, []string{})
+		d.Set(Attr_RemoteCopyRelationships, []map[string]interface{}{})
+		return nil
+	}
+	secondaryVG, err := instance.NewIBMPIVolumeGroupClient(ctx, secondarySess, secondaryID).GetDetails(secondaryVGID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	relationships, err := primaryClient.GetVolumeGroupRemoteCopyRelationships(primaryVGID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	liveDetails, err := primaryClient.GetVolumeGroupLiveDetails(primaryVGID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(Arg_ActiveSite, drPairActiveSite(liveDetails))
+	d.Set(Attr_AuxiliaryVolumeIDs, secondaryVG.VolumeIDs)
+	d.Set(Attr_RemoteCopyRelationships, flattenDRPairRemoteCopyRelationships(relationships.RemoteCopyRelationships))
+
+	return nil
+}
+
+func resourceIBMPIDRPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secondarySess, err := piSessionForZone(sess, d.Get(Arg_SecondaryZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryID, primaryVGID, secondaryID, secondaryVGID, err := splitDRPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Resume a pairing that did not complete on create
+	if secondaryVGID == "" {
+		var auxVolumes []*models.AuxiliaryVolumeForOnboarding
+		var sourceCRN *string
+		if d.Get(Attr_OnboardingID).(string) == "" {
+			volumeIDs := flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List())
+			auxVolumes, sourceCRN, err = drPairAuxiliaryVolumes(ctx, sess, primaryID, volumeIDs)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		err = pairIBMPIDRPair(ctx, d, sess, secondarySess, auxVolumes, sourceCRN, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secondaryVGID = d.Get(Attr_SecondaryVolumeGroupID).(string)
+	}
+
+	if d.HasChange(Arg_ActiveSite) {
+		if d.Get(Arg_ActiveSite).(string) == Secondary {
+			secondaryClient := instance.NewIBMPIVolumeGroupClient(ctx, secondarySess, secondaryID)
+			err = drPairFailover(ctx, secondaryClient, secondaryVGID, d.Timeout(schema.TimeoutUpdate))
+		} else {
+			primaryClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, primaryID)
+			err = drPairFailback(ctx, primaryClient, primaryVGID, d.Timeout(schema.TimeoutUpdate))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIDRPairRead(ctx, d, meta)
+}
+
+func resourceIBMPIDRPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secondarySess, err := piSessionForZone(sess, d.Get(Arg_SecondaryZone).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	primaryID, primaryVGID, secondaryID, secondaryVGID, err := splitDRPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The onboarded auxiliary volumes are kept, only the pairing is removed.
+	// The secondary volume group is missing when the pairing is incomplete.
+	if secondaryVGID != "" {
+		secondaryClient := instance.NewIBMPIVolumeGroupClient(ctx, secondarySess, secondaryID)
+		err = deleteDRPairVolumeGroup(ctx, secondaryClient, secondaryVGID, flex.ExpandStringList(d.Get(Attr_AuxiliaryVolumeIDs).([]interface{})), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	primaryClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, primaryID)
+	err = deleteDRPairVolumeGroup(ctx, primaryClient, primaryVGID, flex.ExpandStringList(d.Get(Arg_VolumeIDs).(*schema.Set).List()), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIDRPairCustomizeDiff plans an update when the pairing did not
+// complete on create, so that the next apply resumes it.
+func resourceIBMPIDRPairCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get(Attr_SecondaryVolumeGroupID).(string) != "" {
+		return nil
+	}
+	for _, attr := range []string{Attr_AuxiliaryVolumeIDs, Attr_OnboardingID, Attr_RemoteCopyRelationships, Attr_ReplicationStatus, Attr_SecondaryVolumeGroupID} {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drPairAuxiliaryVolumes returns the auxiliary volumes to onboard for the
+// primary volumes and the CRN of the primary workspace. The auxiliary volume
+// names are assigned by the storage controller when replication is enabled on
+// the primary volumes.
+func drPairAuxiliaryVolumes(ctx context.Context, sess *ibmpisession.IBMPISession, primaryID string, volumeIDs []string) ([]*models.AuxiliaryVolumeForOnboarding, *string, error) {
+	volClient := instance.NewIBMPIVolumeClient(ctx, sess, primaryID)
+	auxVolumes := make([]*models.AuxiliaryVolumeForOnboarding, 0, len(volumeIDs))
+	for _, volumeID := range volumeIDs {
+		vol, err := volClient.Get(volumeID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if vol.ReplicationEnabled == nil || !*vol.ReplicationEnabled || vol.AuxVolumeName == "" {
+			return nil, nil, fmt.Errorf("volume %s of workspace %s is not replication enabled", volumeID, primaryID)
+		}
+		auxVolumes = append(auxVolumes, &models.AuxiliaryVolumeForOnboarding{
+			AuxVolumeName: sl.String(vol.AuxVolumeName),
+			Name:          *vol.Name,
+		})
+	}
+
+	workspace, err := instance.NewIBMPIWorkspacesClient(ctx, sess, primaryID).Get(primaryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if workspace.Details == nil || workspace.Details.Crn == nil {
+		return nil, nil, fmt.Errorf("workspace %s has no CRN", primaryID)
+	}
+	return auxVolumes, workspace.Details.Crn, nil
+}
+
+// pairIBMPIDRPair onboards the auxiliary volumes in the secondary workspace and
+// creates the secondary volume group once the primary volume group is
+// available. The onboarding ID and the final ID are saved as soon as they are
+// known, and an onboarding that was already started is waited for instead of
+// being started again.
+func pairIBMPIDRPair(ctx context.Context, d *schema.ResourceData, sess, secondarySess *ibmpisession.IBMPISession, auxVolumes []*models.AuxiliaryVolumeForOnboarding, sourceCRN *string, timeout time.Duration) error {
+	primaryID := d.Get(Arg_PrimaryCloudInstanceID).(string)
+	secondaryID := d.Get(Arg_SecondaryCloudInstanceID).(string)
+	primaryVGID := d.Get(Attr_PrimaryVolumeGroupID).(string)
+
+	primaryClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, primaryID)
+	_, err := isWaitForIBMPIVolumeGroupAvailable(ctx, primaryClient, primaryVGID, timeout)
+	if err != nil {
+		return err
+	}
+	primaryDetails, err := primaryClient.GetDetails(primaryVGID)
+	if err != nil {
+		return err
+	}
+
+	onboardingClient := instance.NewIBMPIVolumeOnboardingClient(ctx, secondarySess, secondaryID)
+	onboardingID := d.Get(Attr_OnboardingID).(string)
+	if onboardingID == "" {
+		onboarding, err := onboardingClient.CreateVolumeOnboarding(&models.VolumeOnboardingCreate{
+			Description: fmt.Sprintf("Disaster recovery pairing of volume group %s", d.Get(Arg_VolumeGroupName).(string)),
+			Volumes: []*models.AuxiliaryVolumesForOnboarding{
+				{
+					AuxiliaryVolumes: auxVolumes,
+					SourceCRN:        sourceCRN,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		onboardingID = onboarding.ID
+		d.Set(Attr_OnboardingID, onboardingID)
+	}
+	onboarded, err := isWaitForIBMPIVolumeOnboardingCompleted(ctx, onboardingClient, onboardingID, timeout)
+	if err != nil {
+		return err
+	}
+
+	// The secondary volume group joins the consistency group the storage
+	// controller created for the primary volume group.
+	secondaryClient := instance.NewIBMPIVolumeGroupClient(ctx, secondarySess, secondaryID)
+	secondaryVG, err := secondaryClient.CreateVolumeGroup(&models.VolumeGroupCreate{
+		ConsistencyGroupName: primaryDetails.ConsistencyGroupName,
+		VolumeIDs:            onboarded.(*models.VolumeOnboarding).Results.OnboardedVolumes,
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", primaryID, primaryVGID, secondaryID, *secondaryVG.ID))
+	d.Set(Attr_SecondaryVolumeGroupID, *secondaryVG.ID)
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, secondaryClient, *secondaryVG.ID, timeout)
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForIBMPIDRPairConsistent(ctx, primaryClient, primaryVGID, timeout)
+	return err
+}
+
+func drPairIncompleteDiagnostic(d *schema.ResourceData, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Disaster recovery pairing of volume group %s is incomplete", d.Get(Arg_VolumeGroupName).(string)),
+		Detail:   fmt.Sprintf("%s. The volume groups and the onboarding created so far are kept in state and the next apply resumes the pairing.", err),
+	}
+}
+
+// drPairActiveSite returns the site that serves the volumes of a consistency
+// group. A failover stops the replication with write access to the auxiliary
+// volumes, which leaves the group idling, and while a failback copies the
+// changes back the auxiliary volumes have the primary role.
+func drPairActiveSite(details *models.VolumeGroupStorageDetails) string {
+	if details.State == Idling || details.PrimaryRole == Aux {
+		return Secondary
+	}
+	return Primary
+}
+
+// piZoneRegions maps the zones of Power Systems Virtual Server to the region
+// of their API endpoint.
+var piZoneRegions = map[string]string{
+	"che01":    "che",
+	"dal10":    "dal",
+	"dal12":    "dal",
+	"dal14":    "dal",
+	"eu-de-1":  "eu-de",
+	"eu-de-2":  "eu-de",
+	"lon04":    "lon",
+	"lon06":    "lon",
+	"mad02":    "mad",
+	"mad04":    "mad",
+	"mon01":    "mon",
+	"osa21":    "osa",
+	"sao01":    "sao",
+	"sao04":    "sao",
+	"syd04":    "syd",
+	"syd05":    "syd",
+	"tok04":    "tok",
+	"tor01":    "tor",
+	"us-east":  "us-east",
+	"us-south": "us-south",
+	"wdc06":    "wdc",
+	"wdc07":    "wdc",
+}
+
+// piSessionForZone returns a session for a workspace in another zone than the
+// provider. The region of the zone replaces the region of the provider in the
+// endpoint, so a private or custom endpoint keeps its host name.
+func piSessionForZone(sess *ibmpisession.IBMPISession, zone string) (*ibmpisession.IBMPISession, error) {
+	if zone == "" || zone == sess.Options.Zone {
+		return sess, nil
+	}
+	region, ok := piZoneRegions[zone]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] unknown zone %s, the region of its endpoint cannot be determined", zone)
+	}
+	options := *sess.Options
+	options.Zone = zone
+	if region != options.Region {
+		url, err := piEndpointForRegion(options.URL, options.Region, region)
+		if err != nil {
+			return nil, err
+		}
+		options.Region = region
+		options.URL = url
+	}
+	return ibmpisession.NewIBMPISession(&options)
+}
+
+// piEndpointForRegion replaces the region label of a Power Systems Virtual
+// Server endpoint, for example https://us-south.power-iaas.cloud.ibm.com or
+// https://private.us-south.power-iaas.cloud.ibm.com.
+func piEndpointForRegion(url, from, to string) (string, error) {
+	for _, separator := range []string{"://", "."} {
+		label := separator + from + "."
+		if strings.Contains(url, label) {
+			return strings.Replace(url, label, separator+to+".", 1), nil
+		}
+	}
+	return "", fmt.Errorf("[ERROR] the endpoint %s is not in region %s, the endpoint of region %s cannot be derived from it", url, from, to)
+}
+
+func splitDRPairID(id string) (primaryID, primaryVGID, secondaryID, secondaryVGID string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return
+	}
+	if len(parts) != 4 {
+		err = fmt.Errorf("[ERROR] unexpected ID %s, expected <primary cloud instance ID>/<primary volume group ID>/<secondary cloud instance ID>/<secondary volume group ID>", id)
+		return
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+// drPairFailover stops the replication from the secondary workspace and gives
+// the secondary workspace write access to the auxiliary volumes.
+func drPairFailover(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, timeout time.Duration) error {
+	_, err := client.VolumeGroupAction(id, &models.VolumeGroupAction{
+		Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, id, timeout)
+	return err
+}
+
+// drPairFailback copies the changes made on the secondary workspace back to
+// the primary workspace, then restores the replication from the primary
+// workspace.
+func drPairFailback(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, timeout time.Duration) error {
+	actions := []*models.VolumeGroupAction{
+		{Start: &models.VolumeGroupActionStart{Source: sl.String(Aux)}},
+		{Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)}},
+		{Start: &models.VolumeGroupActionStart{Source: sl.String(Master)}},
+	}
+	for _, action := range actions {
+		_, err := client.VolumeGroupAction(id, action)
+		if err != nil {
+			return err
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, id, timeout)
+		if err != nil {
+			return err
+		}
+		if action.Start != nil {
+			_, err = isWaitForIBMPIDRPairConsistent(ctx, client, id, timeout)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func deleteDRPairVolumeGroup(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, volumeIDs []string, timeout time.Duration) error {
+	if len(volumeIDs) > 0 {
+		err := client.UpdateVolumeGroup(id, &models.VolumeGroupUpdate{RemoveVolumes: volumeIDs})
+		if err != nil {
+			return err
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, id, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	err := client.DeleteVolumeGroup(id)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		if _, ok := uErr.(*p_cloud_volume_groups.PcloudVolumegroupsDeleteNotFound); ok {
+			return nil
+		}
+		return err
+	}
+	_, err = isWaitForIBMPIVolumeGroupDeleted(ctx, client, id, timeout)
+	return err
+}
+
+func flattenDRPairRemoteCopyRelationships(relationships []*models.RemoteCopyRelationship) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(relationships))
+	for _, r := range relationships {
+		if r == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			Attr_AuxiliaryVolumeName: r.AuxVolumeName,
+			Attr_MasterVolumeName:    r.MasterVolumeName,
+			Attr_Name:                *r.Name,
+			Attr_PrimaryRole:         r.PrimaryRole,
+			Attr_Progress:            r.Progress,
+			Attr_State:               r.State,
+			Attr_Synchronized:        r.Sync,
+		})
+	}
+	return result
+}
+
+func isWaitForIBMPIVolumeOnboardingCompleted(ctx context.Context, client *instance.IBMPIVolumeOnboardingClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Onboarding (%s) to be completed.", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{State_Completed},
+		Refresh:    isIBMPIVolumeOnboardingRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeOnboardingRefreshFunc(client *instance.IBMPIVolumeOnboardingClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		onboarding, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if onboarding.Results != nil && len(onboarding.Results.VolumeOnboardingFailures) > 0 {
+			failures := make([]string, 0, len(onboarding.Results.VolumeOnboardingFailures))
+			for _, f := range onboarding.Results.VolumeOnboardingFailures {
+				failures = append(failures, fmt.Sprintf("%s: %s", strings.Join(f.Volumes, ", "), f.FailureMessage))
+			}
+			return onboarding, State_Failed, fmt.Errorf("[ERROR] volume onboarding %s failed: %s", id, strings.Join(failures, "; "))
+		}
+		if strings.EqualFold(onboarding.Status, State_Failed) || strings.EqualFold(onboarding.Status, State_Error) {
+			return onboarding, State_Failed, fmt.Errorf("[ERROR] volume onboarding %s failed with status %s", id, onboarding.Status)
+		}
+		if onboarding.Progress >= 100 && onboarding.Results != nil {
+			return onboarding, State_Completed, nil
+		}
+		return onboarding, State_InProgress, nil
+	}
+}
+
+// isWaitForIBMPIDRPairConsistent waits until every remote copy relationship
+// of the volume group is in a consistent state.
+func isWaitForIBMPIDRPairConsistent(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the remote copy relationships of Volume Group (%s) to be consistent.", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Pending},
+		Target:     []string{State_Available},
+		Refresh:    isIBMPIDRPairConsistentRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIDRPairConsistentRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		relationships, err := client.GetVolumeGroupRemoteCopyRelationships(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(relationships.RemoteCopyRelationships) == 0 {
+			return relationships, State_Pending, nil
+		}
+		for _, r := range relationships.RemoteCopyRelationships {
+			switch {
+			case strings.HasPrefix(r.State, "consistent_"):
+			case strings.HasPrefix(r.State, "inconsistent_"), r.State == "":
+				return relationships, State_Pending, nil
+			default:
+				return relationships, r.State, fmt.Errorf("[ERROR] remote copy relationship %s of volume group %s is %s", *r.Name, id, r.State)
+			}
+		}
+		return relationships, State_Available, nil
+	}
+}
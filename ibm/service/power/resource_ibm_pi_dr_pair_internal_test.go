@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func TestDRPairActiveSite(t *testing.T) {
+	tests := []struct {
+		name    string
+		details *models.VolumeGroupStorageDetails
+		want    string
+	}{
+		{
+			name:    "Replicating from the primary workspace",
+			details: &models.VolumeGroupStorageDetails{State: "consistent_synchronized", PrimaryRole: Master},
+			want:    Primary,
+		},
+		{
+			name:    "Failed over with access to the auxiliary volumes",
+			details: &models.VolumeGroupStorageDetails{State: Idling},
+			want:    Secondary,
+		},
+		{
+			name:    "Copying the changes of the secondary workspace back",
+			details: &models.VolumeGroupStorageDetails{State: "inconsistent_copying", PrimaryRole: Aux},
+			want:    Secondary,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := drPairActiveSite(tt.details); got != tt.want {
+				t.Errorf("drPairActiveSite() got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPIEndpointForRegion(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Public endpoint",
+			url:  "https://us-south.power-iaas.cloud.ibm.com",
+			want: "https://wdc.power-iaas.cloud.ibm.com",
+		},
+		{
+			name: "Private endpoint",
+			url:  "https://private.us-south.power-iaas.cloud.ibm.com",
+			want: "https://private.wdc.power-iaas.cloud.ibm.com",
+		},
+		{
+			name:    "Endpoint of another region",
+			url:     "https://dal.power-iaas.cloud.ibm.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := piEndpointForRegion(tt.url, "us-south", "wdc")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("piEndpointForRegion() error %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("piEndpointForRegion() got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
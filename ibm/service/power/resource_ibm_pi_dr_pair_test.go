@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIDRPairBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-dr-pair-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDRPairConfig(name, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDRPairExists("ibm_pi_dr_pair.power_dr_pair"),
+					resource.TestCheckResourceAttr("ibm_pi_dr_pair.power_dr_pair", "pi_volume_group_name", name),
+					resource.TestCheckResourceAttrSet("ibm_pi_dr_pair.power_dr_pair", "consistency_group_name"),
+					resource.TestCheckResourceAttrSet("ibm_pi_dr_pair.power_dr_pair", "secondary_volume_group_id"),
+					resource.TestCheckResourceAttr("ibm_pi_dr_pair.power_dr_pair", "auxiliary_volume_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIDRPairConfig(name, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDRPairExists("ibm_pi_dr_pair.power_dr_pair"),
+					resource.TestCheckResourceAttr("ibm_pi_dr_pair.power_dr_pair", "pi_active_site", "secondary"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIDRPairConfig(name, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDRPairExists("ibm_pi_dr_pair.power_dr_pair"),
+					resource.TestCheckResourceAttr("ibm_pi_dr_pair.power_dr_pair", "pi_active_site", "primary"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDRPairExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		ids, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIVolumeGroupClient(context.Background(), sess, ids[0])
+		_, err = client.Get(ids[1])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIDRPairConfig(name, activeSite string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_dr_pair" "power_dr_pair" {
+		pi_active_site                 = "%[4]s"
+		pi_primary_cloud_instance_id   = "%[1]s"
+		pi_secondary_cloud_instance_id = "%[2]s"
+		pi_volume_group_name           = "%[3]s"
+		pi_volume_ids                  = ["%[5]s"]
+	}`, acc.Pi_cloud_instance_id, acc.Pi_secondary_workspace_id_1, name, activeSite, acc.Pi_replication_volume_id)
+}
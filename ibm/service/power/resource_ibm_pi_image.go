@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -36,12 +39,13 @@ func ResourceIBMPIImage() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
-			Update: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIImageImportCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -109,11 +113,12 @@ func ResourceIBMPIImage() *schema.Resource {
 			},
 			Arg_ImageBucketFileName: {
 				ConflictsWith: []string{Arg_ImageID},
-				Description:   "Cloud Object Storage image filename",
+				Description:   "Cloud Object Storage image filename; one of .ova, .ova.gz, .tar, .tar.gz or .tgz",
 				ForceNew:      true,
 				Optional:      true,
 				RequiredWith:  []string{Arg_ImageBucketName},
 				Type:          schema.TypeString,
+				ValidateFunc:  validateIBMPIImageBucketFileName,
 			},
 			Arg_ImageBucketName: {
 				ConflictsWith: []string{Arg_ImageID},
@@ -123,6 +128,7 @@ func ResourceIBMPIImage() *schema.Resource {
 				Optional:      true,
 				RequiredWith:  []string{Arg_ImageBucketRegion, Arg_ImageBucketFileName, Arg_ImageName},
 				Type:          schema.TypeString,
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9](/.+)?$`), "must be a Cloud Object Storage bucket name, optionally followed by /folder"),
 			},
 			Arg_ImageBucketRegion: {
 				ConflictsWith: []string{Arg_ImageID},
@@ -131,6 +137,7 @@ func ResourceIBMPIImage() *schema.Resource {
 				Optional:      true,
 				RequiredWith:  []string{Arg_ImageBucketName},
 				Type:          schema.TypeString,
+				ValidateFunc:  validate.ValidateAllowedStringValues([]string{"au-syd", "br-sao", "ca-tor", "che01", "eu-de", "eu-es", "eu-gb", "jp-osa", "jp-tok", "us-east", "us-south"}),
 			},
 			Arg_ImageID: {
 				ConflictsWith: []string{Arg_ImageBucketName},
@@ -217,6 +224,35 @@ func ResourceIBMPIImage() *schema.Resource {
 				Description: "Image ID",
 				Type:        schema.TypeString,
 			},
+			Attr_ImportJob: {
+				Computed:    true,
+				Description: "The Cloud Object Storage import job of the image.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the import job.",
+							Type:        schema.TypeString,
+						},
+						Attr_Message: {
+							Computed:    true,
+							Description: "The status message of the import job.",
+							Type:        schema.TypeString,
+						},
+						Attr_Progress: {
+							Computed:    true,
+							Description: "The progress of the import job.",
+							Type:        schema.TypeString,
+						},
+						Attr_State: {
+							Computed:    true,
+							Description: "The state of the import job.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
 		},
 	}
 }
@@ -340,9 +376,19 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(err)
 		}
 
+		// Until the job is completed the ID refers to the import job, so that a
+		// timed out import is kept in state and resumed by the next apply.
+		jobID := *imageResponse.ID
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, jobID))
+		d.Set(Attr_ImportJob, []map[string]interface{}{{Attr_ID: jobID}})
+
 		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+		job, err := waitForIBMPIImageImportJob(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		d.Set(Attr_ImportJob, flattenIBMPIImageImportJob(jobID, job))
 		if err != nil {
+			if isIBMPIImageImportTimeout(ctx, err) {
+				return diag.Diagnostics{imageImportJobPendingDiagnostic(jobID, job)}
+			}
 			return diag.FromErr(err)
 		}
 
@@ -379,6 +425,33 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	imageC := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	if isIBMPIImageImportPending(d.Id(), d.Get(Attr_ImportJob).([]interface{})) {
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		job, err := jobClient.Get(imageID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(Attr_ImportJob, flattenIBMPIImageImportJob(imageID, job))
+		switch *job.Status.State {
+		case State_Completed:
+			image, err := imageC.Get(d.Get(Arg_ImageName).(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			imageID = *image.ImageID
+			d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, imageID))
+		case State_Failed:
+			d.SetId("")
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Image import job %s failed, the image will be imported again", imageID),
+				Detail:   job.Status.Message,
+			}}
+		default:
+			return nil
+		}
+	}
+
 	imagedata, err := imageC.Get(imageID)
 	if err != nil {
 		uErr := errors.Unwrap(err)
@@ -409,11 +482,35 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	_, imageID, err := splitID(d.Id())
+	cloudInstanceID, imageID, err := splitID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Resume the wait on an import job that outlived the create timeout.
+	if isIBMPIImageImportPending(d.Id(), d.Get(Attr_ImportJob).([]interface{})) {
+		sess, err := meta.(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		job, err := waitForIBMPIImageImportJob(ctx, jobClient, imageID, d.Timeout(schema.TimeoutUpdate))
+		d.Set(Attr_ImportJob, flattenIBMPIImageImportJob(imageID, job))
+		if err != nil {
+			if isIBMPIImageImportTimeout(ctx, err) {
+				return diag.Diagnostics{imageImportJobPendingDiagnostic(imageID, job)}
+			}
+			return diag.FromErr(err)
+		}
+		image, err := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).Get(d.Get(Arg_ImageName).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		imageID = *image.ImageID
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, imageID))
+		d.Set(Attr_CRN, image.Crn)
+	}
+
 	if d.HasChange(Arg_UserTags) {
 		if crn, ok := d.GetOk(Attr_CRN); ok {
 			oldList, newList := d.GetChange(Arg_UserTags)
@@ -439,6 +536,33 @@ func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	imageC := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	if isIBMPIImageImportPending(d.Id(), d.Get(Attr_ImportJob).([]interface{})) {
+		// Cancel an import job that is still running, then remove whatever
+		// image it has created so far.
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		job, err := jobClient.Get(imageID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if state := *job.Status.State; state != State_Completed && state != State_Failed {
+			err = jobClient.Delete(imageID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		image, err := imageC.Get(d.Get(Arg_ImageName).(string))
+		if err != nil {
+			uErr := errors.Unwrap(err)
+			switch uErr.(type) {
+			case *p_cloud_images.PcloudCloudinstancesImagesGetNotFound:
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+		imageID = *image.ImageID
+	}
+
 	err = imageC.Delete(imageID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -506,3 +630,116 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *instance.IBMPIJobClie
 	}
 	return stateConf.WaitForStateContext(ctx)
 }
+
+// waitForIBMPIImageImportJob waits for an image import job, logging the
+// progress reported by the job. The last job read is returned, also when the
+// wait fails.
+func waitForIBMPIImageImportJob(ctx context.Context, client *instance.IBMPIJobClient, jobID string, timeout time.Duration) (*models.Job, error) {
+	var last atomic.Pointer[models.Job]
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Queued, State_ReadyForProcessing, State_inProgress, State_Running, State_Waiting},
+		Target:  []string{State_Completed},
+		Refresh: func() (interface{}, string, error) {
+			job, err := client.Get(jobID)
+			if err != nil {
+				log.Printf("[DEBUG] get job failed %v", err)
+				return nil, "", fmt.Errorf(errors.GetJobOperationFailed, jobID, err)
+			}
+			if job == nil || job.Status == nil || job.Status.State == nil {
+				return nil, "", fmt.Errorf("failed to get job status for job id %s", jobID)
+			}
+			if previous := last.Swap(job); previous == nil || imageImportJobProgress(previous) != imageImportJobProgress(job) {
+				log.Printf("[INFO] Image import job %s is %s, progress %s: %s", jobID, *job.Status.State, imageImportJobProgress(job), job.Status.Message)
+			}
+			if *job.Status.State == State_Failed {
+				return job, State_Failed, fmt.Errorf("image import job %s failed at progress %s with message: %s", jobID, imageImportJobProgress(job), job.Status.Message)
+			}
+			return job, *job.Status.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return last.Load(), err
+}
+
+// isIBMPIImageImportTimeout reports whether the wait for an import job ended
+// because the operation timed out rather than because the job failed.
+func isIBMPIImageImportTimeout(ctx context.Context, err error) bool {
+	if _, ok := err.(*retry.TimeoutError); ok {
+		return true
+	}
+	return ctx.Err() == context.DeadlineExceeded
+}
+
+// isIBMPIImageImportPending reports whether the resource ID still refers to
+// the import job instead of the imported image.
+func isIBMPIImageImportPending(id string, importJob []interface{}) bool {
+	if len(importJob) == 0 || importJob[0] == nil {
+		return false
+	}
+	_, resourceID, err := splitID(id)
+	if err != nil {
+		return false
+	}
+	return resourceID == importJob[0].(map[string]interface{})[Attr_ID].(string)
+}
+
+func imageImportJobProgress(job *models.Job) string {
+	if job == nil || job.Status == nil || job.Status.Progress == nil {
+		return "unknown"
+	}
+	return *job.Status.Progress
+}
+
+func imageImportJobPendingDiagnostic(jobID string, job *models.Job) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Image import job %s is still running", jobID),
+		Detail:   fmt.Sprintf("The import job did not complete within the timeout, its progress is %s. The job ID is kept in state and the next apply resumes the wait.", imageImportJobProgress(job)),
+	}
+}
+
+func flattenIBMPIImageImportJob(jobID string, job *models.Job) []map[string]interface{} {
+	result := map[string]interface{}{
+		Attr_ID: jobID,
+	}
+	if job != nil && job.Status != nil && job.Status.State != nil {
+		result[Attr_Message] = job.Status.Message
+		result[Attr_Progress] = imageImportJobProgress(job)
+		result[Attr_State] = *job.Status.State
+	}
+	return []map[string]interface{}{result}
+}
+
+// resourceIBMPIImageImportCustomizeDiff checks the Cloud Object Storage
+// credentials at plan time and plans an update to resume the wait on an import
+// job that is still running.
+func resourceIBMPIImageImportCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		if _, ok := diff.GetOk(Arg_ImageBucketName); ok && diff.NewValueKnown(Arg_ImageBucketAccess) && diff.Get(Arg_ImageBucketAccess).(string) == Private {
+			_, accessKey := diff.GetOk(Arg_ImageAccessKey)
+			_, secretKey := diff.GetOk(Arg_ImageSecretKey)
+			if !accessKey && !secretKey && diff.NewValueKnown(Arg_ImageAccessKey) && diff.NewValueKnown(Arg_ImageSecretKey) {
+				return fmt.Errorf("%s and %s are required when %s is %s", Arg_ImageAccessKey, Arg_ImageSecretKey, Arg_ImageBucketAccess, Private)
+			}
+		}
+		return nil
+	}
+	if isIBMPIImageImportPending(diff.Id(), diff.Get(Attr_ImportJob).([]interface{})) {
+		return diff.SetNewComputed(Attr_ImageID)
+	}
+	return nil
+}
+
+func validateIBMPIImageBucketFileName(v interface{}, k string) (warnings []string, errs []error) {
+	name := strings.ToLower(v.(string))
+	for _, suffix := range []string{".ova", ".ova.gz", ".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(name, suffix) {
+			return
+		}
+	}
+	errs = append(errs, fmt.Errorf("%q must be an .ova, .ova.gz, .tar, .tar.gz or .tgz file, got %q", k, v.(string)))
+	return
+}
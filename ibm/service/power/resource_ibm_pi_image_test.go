@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -114,12 +115,45 @@ func TestAccIBMPIImageCOSPublicImport(t *testing.T) {
 					testAccCheckIBMPIImageExists(imageRes),
 					resource.TestCheckResourceAttr(imageRes, "pi_image_name", name),
 					resource.TestCheckResourceAttrSet(imageRes, "image_id"),
+					resource.TestCheckResourceAttrSet(imageRes, "import_job.0.id"),
+					resource.TestCheckResourceAttr(imageRes, "import_job.0.state", "completed"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccIBMPIImageCOSImportValidation(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-image-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIImageCOSValidationConfig(name, "public", "image.qcow2"),
+				ExpectError: regexp.MustCompile(`must be an .ova, .ova.gz, .tar, .tar.gz or .tgz file`),
+			},
+			{
+				Config:      testAccCheckIBMPIImageCOSValidationConfig(name, "private", acc.Pi_image_bucket_file_name),
+				ExpectError: regexp.MustCompile(`pi_image_access_key and pi_image_secret_key are required`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImageCOSValidationConfig(name, access, fileName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image" "cos_image" {
+		pi_image_name             = "%[1]s"
+		pi_cloud_instance_id      = "%[2]s"
+		pi_image_bucket_name      = "%[3]s"
+		pi_image_bucket_access    = "%[4]s"
+		pi_image_bucket_region    = "us-east"
+		pi_image_bucket_file_name = "%[5]s"
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, access, fileName)
+}
+
 func testAccCheckIBMPIImageCOSPublicConfig(name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_pi_image" "cos_image" {
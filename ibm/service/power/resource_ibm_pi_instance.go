@@ -42,6 +42,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			piCapacityCheckCustomizeDiff(ResourceIBMPIInstance, piInstanceCapacityRequest),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -84,6 +85,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			Arg_CapacityCheck: {
+				Description:  "Check at plan time that the workspace has free capacity for all planned instances and volumes that set pi_capacity_check. With warn the shortage is logged and reported as a warning on create, with fail the plan fails.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Warn, Fail}),
+			},
 			Arg_CloudInstanceID: {
 				Description: "This is the Power Instance id that is assigned to the account",
 				ForceNew:    true,
@@ -530,6 +537,8 @@ func ResourceIBMPIInstance() *schema.Resource {
 }
 
 func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("instance %s", d.Get(Arg_InstanceName).(string)))
+	ctx = contextWithPIPollInterval(ctx, meta)
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -622,7 +631,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return append(piCapacityCheckWarnings(d), resourceIBMPIInstanceRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -756,6 +765,8 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("instance %s", d.Get(Arg_InstanceName).(string)))
+	ctx = contextWithPIPollInterval(ctx, meta)
 	name := d.Get(Arg_InstanceName).(string)
 	mem := d.Get(Arg_Memory).(float64)
 	procs := d.Get(Arg_Processors).(float64)
@@ -1189,6 +1200,8 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("instance %s", d.Get(Arg_InstanceName).(string)))
+	ctx = contextWithPIPollInterval(ctx, meta)
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.FromErr(err)
@@ -1231,26 +1244,18 @@ func isWaitForPIInstanceDeleted(ctx context.Context, client *instance.IBMPIInsta
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Retry, State_Deleting},
-		Target:     []string{State_NotFound},
-		Refresh:    isPIInstanceDeleteRefreshFunc(client, id),
-		Delay:      Timeout_Delay,
-		MinTimeout: Timeout_Active,
-		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
+		Pending:  []string{State_Retry, State_Deleting},
+		Target:   []string{State_NotFound},
+		State:    isPIInstanceDeleteRefreshFunc(),
+		Timeout:  timeout,
+		NotFound: State_NotFound,
+	})
 }
 
-func isPIInstanceDeleteRefreshFunc(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		pvm, err := client.Get(id)
-		if err != nil {
-			log.Printf("The power vm does not exist")
-			return pvm, State_NotFound, nil
-		}
-		return pvm, State_Deleting, nil
+func isPIInstanceDeleteRefreshFunc() piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
+		return State_Deleting, nil
 	}
 }
 
@@ -1262,74 +1267,56 @@ func isWaitForPIInstanceAvailable(ctx context.Context, client *instance.IBMPIIns
 		queryTimeOut = Timeout_Warning
 	}
 
-	stateConf := &retry.StateChangeConf{
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
 		Pending:    []string{State_Pending, State_Build, Warning},
 		Target:     []string{State_Active, OK, State_Error, "", State_Shutoff},
-		Refresh:    isPIInstanceRefreshFunc(client, id, instanceReadyStatus),
-		Delay:      Timeout_Delay,
+		State:      isPIInstanceRefreshFunc(instanceReadyStatus),
 		MinTimeout: queryTimeOut,
 		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	})
 }
 
-func isPIInstanceRefreshFunc(client *instance.IBMPIInstanceClient, id, instanceReadyStatus string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
+func isPIInstanceRefreshFunc(instanceReadyStatus string) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		// Check for `instanceReadyStatus` health status and also the final health status "OK"
 		if strings.ToLower(*pvm.Status) == State_Active && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == OK) {
-			return pvm, State_Active, nil
+			return State_Active, nil
 		}
 		if strings.ToLower(*pvm.Status) == State_Error {
 			if pvm.Fault != nil {
-				err = fmt.Errorf("failed to create the lpar: %s", pvm.Fault.Message)
-			} else {
-				err = fmt.Errorf("failed to create the lpar")
+				return *pvm.Status, fmt.Errorf("failed to create the lpar: %s", pvm.Fault.Message)
 			}
-			return pvm, *pvm.Status, err
+			return *pvm.Status, fmt.Errorf("failed to create the lpar")
 		}
 
-		return pvm, State_Build, nil
+		return State_Build, nil
 	}
 }
 
 func isWaitForPIInstanceAvailableOrShutoffAfterUpdate(ctx context.Context, client *instance.IBMPIInstanceClient, id string, instanceReadyStatus string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be available and active or shutoff ", id)
 
-	stateConf := &retry.StateChangeConf{
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
 		Pending:    []string{State_Updating, Warning},
 		Target:     []string{State_Active, OK, State_Shutoff},
-		Refresh:    isPIInstanceShutoffOrActiveAfterResourceChange(client, id, instanceReadyStatus),
-		Delay:      Timeout_Delay,
+		State:      isPIInstanceShutoffOrActiveAfterResourceChange(instanceReadyStatus),
 		MinTimeout: 5 * time.Minute,
 		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	})
 }
 
-func isPIInstanceShutoffOrActiveAfterResourceChange(client *instance.IBMPIInstanceClient, id string, instanceReadyStatus string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
+func isPIInstanceShutoffOrActiveAfterResourceChange(instanceReadyStatus string) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		if strings.ToLower(*pvm.Status) == State_Active && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == OK) {
 			log.Printf("The lpar is now active after the resource change...")
-			return pvm, State_Active, nil
+			return State_Active, nil
 		}
 		if strings.ToLower(*pvm.Status) == State_Shutoff && pvm.Health.Status == OK {
 			log.Printf("The lpar is now off after the resource change...")
-			return pvm, State_Shutoff, nil
+			return State_Shutoff, nil
 		}
 
-		return pvm, State_Updating, nil
+		return State_Updating, nil
 	}
 }
 
@@ -1337,12 +1324,13 @@ func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *instance.
 	log.Printf("Waiting for PIInstance Placement Group (%s) to be updated ", id)
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Adding},
-		Target:     []string{State_Added},
-		Refresh:    isPIInstancePlacementGroupAddRefreshFunc(client, pgID, id),
-		Delay:      Timeout_Delay,
-		MinTimeout: Timeout_Active,
-		Timeout:    timeout,
+		Pending:      []string{State_Adding},
+		Target:       []string{State_Added},
+		Refresh:      isPIInstancePlacementGroupAddRefreshFunc(client, pgID, id),
+		Delay:        Timeout_Delay,
+		MinTimeout:   Timeout_Active,
+		PollInterval: piPollInterval(ctx),
+		Timeout:      timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1369,12 +1357,13 @@ func isWaitForPIInstancePlacementGroupDelete(ctx context.Context, client *instan
 	queryTimeOut := Timeout_Active
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Deleting},
-		Target:     []string{State_Deleted},
-		Refresh:    isPIInstancePlacementGroupDeleteRefreshFunc(client, pgID, id),
-		Delay:      Timeout_Delay,
-		MinTimeout: queryTimeOut,
-		Timeout:    timeout,
+		Pending:      []string{State_Deleting},
+		Target:       []string{State_Deleted},
+		Refresh:      isPIInstancePlacementGroupDeleteRefreshFunc(client, pgID, id),
+		Delay:        Timeout_Delay,
+		MinTimeout:   queryTimeOut,
+		PollInterval: piPollInterval(ctx),
+		Timeout:      timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1398,38 +1387,26 @@ func isPIInstancePlacementGroupDeleteRefreshFunc(client *instance.IBMPIPlacement
 func isWaitForPIInstanceSoftwareLicenses(ctx context.Context, client *instance.IBMPIInstanceClient, id string, softwareLicenses *models.SoftwareLicenses, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance Software Licenses (%s) to be updated ", id)
 
-	queryTimeOut := Timeout_Active
-
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_InProgress},
-		Target:     []string{State_Available},
-		Refresh:    isPIInstanceSoftwareLicensesRefreshFunc(client, id, softwareLicenses),
-		Delay:      Timeout_Delay,
-		MinTimeout: queryTimeOut,
-		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
+		Pending: []string{State_InProgress},
+		Target:  []string{State_Available},
+		State:   isPIInstanceSoftwareLicensesRefreshFunc(softwareLicenses),
+		Timeout: timeout,
+	})
 }
 
-func isPIInstanceSoftwareLicensesRefreshFunc(client *instance.IBMPIInstanceClient, id string, softwareLicenses *models.SoftwareLicenses) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
-
+func isPIInstanceSoftwareLicensesRefreshFunc(softwareLicenses *models.SoftwareLicenses) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		// Check that each software license we modified has been updated
 		if softwareLicenses.IbmiCSS != nil {
 			if *softwareLicenses.IbmiCSS != *pvm.SoftwareLicenses.IbmiCSS {
-				return pvm, State_InProgress, nil
+				return State_InProgress, nil
 			}
 		}
 
 		if softwareLicenses.IbmiPHA != nil {
 			if *softwareLicenses.IbmiPHA != *pvm.SoftwareLicenses.IbmiPHA {
-				return pvm, State_InProgress, nil
+				return State_InProgress, nil
 			}
 		}
 
@@ -1437,14 +1414,14 @@ func isPIInstanceSoftwareLicensesRefreshFunc(client *instance.IBMPIInstanceClien
 			// If the update set IBMiRDS to false, don't check IBMiRDSUsers as it will be updated on the terraform side on the read
 			if !*softwareLicenses.IbmiRDS {
 				if *softwareLicenses.IbmiRDS != *pvm.SoftwareLicenses.IbmiRDS {
-					return pvm, State_InProgress, nil
+					return State_InProgress, nil
 				}
 			} else if (*softwareLicenses.IbmiRDS != *pvm.SoftwareLicenses.IbmiRDS) || (softwareLicenses.IbmiRDSUsers != pvm.SoftwareLicenses.IbmiRDSUsers) {
-				return pvm, State_InProgress, nil
+				return State_InProgress, nil
 			}
 		}
 
-		return pvm, State_Available, nil
+		return State_Available, nil
 	}
 }
 
@@ -1456,38 +1433,28 @@ func isWaitForPIInstanceShutoff(ctx context.Context, client *instance.IBMPIInsta
 		queryTimeOut = Timeout_Warning
 	}
 
-	stateConf := &retry.StateChangeConf{
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
 		Pending:    []string{State_Pending, State_Build, Warning},
 		Target:     []string{OK, State_Error, "", State_Shutoff},
-		Refresh:    isPIInstanceShutoffRefreshFunc(client, id, instanceReadyStatus),
-		Delay:      Timeout_Delay,
+		State:      isPIInstanceShutoffRefreshFunc(instanceReadyStatus),
 		MinTimeout: queryTimeOut,
 		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	})
 }
 
-func isPIInstanceShutoffRefreshFunc(client *instance.IBMPIInstanceClient, id, instanceReadyStatus string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
+func isPIInstanceShutoffRefreshFunc(instanceReadyStatus string) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		if strings.ToLower(*pvm.Status) == State_Shutoff && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == OK) {
-			return pvm, State_Shutoff, nil
+			return State_Shutoff, nil
 		}
 		if strings.ToLower(*pvm.Status) == State_Error {
 			if pvm.Fault != nil {
-				err = fmt.Errorf("failed to create the lpar: %s", pvm.Fault.Message)
-			} else {
-				err = fmt.Errorf("failed to create the lpar")
+				return *pvm.Status, fmt.Errorf("failed to create the lpar: %s", pvm.Fault.Message)
 			}
-			return pvm, *pvm.Status, err
+			return *pvm.Status, fmt.Errorf("failed to create the lpar")
 		}
 
-		return pvm, State_Build, nil
+		return State_Build, nil
 	}
 }
 
@@ -1503,30 +1470,21 @@ func encodeBase64(userData string) string {
 func isWaitForPIInstanceStopped(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Stopping, State_Resize, State_VerifyResize, Warning},
-		Target:     []string{OK, State_Shutoff},
-		Refresh:    isPIInstanceRefreshFuncOff(client, id),
-		Delay:      Timeout_Delay,
-		MinTimeout: Timeout_Active, // This is the time that the client will execute to check the status of the request
-		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
+		Pending: []string{State_Stopping, State_Resize, State_VerifyResize, Warning},
+		Target:  []string{OK, State_Shutoff},
+		State:   isPIInstanceRefreshFuncOff(id),
+		Timeout: timeout,
+	})
 }
 
-func isPIInstanceRefreshFuncOff(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
+func isPIInstanceRefreshFuncOff(id string) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		log.Printf("Calling the check Refresh status of the pvm instance %s", id)
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
 		if strings.ToLower(*pvm.Status) == State_Shutoff && pvm.Health.Status == OK {
-			return pvm, State_Shutoff, nil
+			return State_Shutoff, nil
 		}
-		return pvm, State_Stopping, nil
+		return State_Stopping, nil
 	}
 }
 
@@ -1606,32 +1564,23 @@ func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceC
 func isWaitForPIInstanceShutoffAfterUpdate(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be ACTIVE or SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
-	stateConf := &retry.StateChangeConf{
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
 		Pending:    []string{State_Resize, State_VerifyResize},
 		Target:     []string{State_Active, State_Shutoff, OK},
-		Refresh:    isPIInstanceShutAfterResourceChange(client, id),
-		Delay:      Timeout_Delay,
+		State:      isPIInstanceShutAfterResourceChange(),
 		MinTimeout: 5 * time.Minute,
 		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	})
 }
 
-func isPIInstanceShutAfterResourceChange(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
-
+func isPIInstanceShutAfterResourceChange() piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		if strings.ToLower(*pvm.Status) == State_Shutoff && pvm.Health.Status == OK {
 			log.Printf("The lpar is now off after the resource change...")
-			return pvm, State_Shutoff, nil
+			return State_Shutoff, nil
 		}
 
-		return pvm, State_Resize, nil
+		return State_Resize, nil
 	}
 }
 
@@ -2104,32 +2053,24 @@ func isWaitForPIInstanceVSNAssignedOrUpdatedAndStopped(ctx context.Context, clie
 
 	log.Printf("Waiting until VSN assigned to %s or updated.", id)
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Updating},
-		Target:     []string{State_Completed},
-		Refresh:    isPIInstanceVSNAssignedOrUpdatedAndStoppedRefreshFunc(client, id, updateBody),
-		Delay:      Timeout_Delay,
-		MinTimeout: Timeout_Active,
-		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
+		Pending: []string{State_Updating},
+		Target:  []string{State_Completed},
+		State:   isPIInstanceVSNAssignedOrUpdatedAndStoppedRefreshFunc(updateBody),
+		Timeout: timeout,
+	})
 }
 
-func isPIInstanceVSNAssignedOrUpdatedAndStoppedRefreshFunc(client *instance.IBMPIInstanceClient, id string, updateBody *models.UpdateServerVirtualSerialNumber) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
+func isPIInstanceVSNAssignedOrUpdatedAndStoppedRefreshFunc(updateBody *models.UpdateServerVirtualSerialNumber) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		if updateBody != nil && updateBody.SoftwareTier != "" && pvm.VirtualSerialNumber != nil && pvm.VirtualSerialNumber.SoftwareTier != updateBody.SoftwareTier {
-			return pvm, State_Updating, nil
+			return State_Updating, nil
 		}
 		if pvm.VirtualSerialNumber != nil && strings.ToLower(*pvm.Status) == State_Shutoff && pvm.Health.Status == OK {
-			return pvm, State_Completed, nil
+			return State_Completed, nil
 		}
 
-		return pvm, State_Updating, nil
+		return State_Updating, nil
 	}
 }
 
@@ -2137,28 +2078,20 @@ func isWaitForPIInstanceVSNRemoved(ctx context.Context, client *instance.IBMPIIn
 
 	log.Printf("Waiting until VSN removed from %s.", id)
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{State_Removing},
-		Target:     []string{State_Shutoff},
-		Refresh:    isPIInstanceVSNRemovedRefreshFunc(client, id),
-		Delay:      Timeout_Delay,
-		MinTimeout: Timeout_Active,
-		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
+		Pending: []string{State_Removing},
+		Target:  []string{State_Shutoff},
+		State:   isPIInstanceVSNRemovedRefreshFunc(),
+		Timeout: timeout,
+	})
 }
 
-func isPIInstanceVSNRemovedRefreshFunc(client *instance.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
+func isPIInstanceVSNRemovedRefreshFunc() piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		if pvm.VirtualSerialNumber == nil && strings.ToLower(*pvm.Status) == State_Shutoff && pvm.Health.Status == OK {
-			return pvm, State_Shutoff, nil
+			return State_Shutoff, nil
 		}
 
-		return pvm, State_Removing, nil
+		return State_Removing, nil
 	}
 }
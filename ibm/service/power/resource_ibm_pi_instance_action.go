@@ -16,7 +16,6 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -142,6 +141,7 @@ func resourceIBMPIInstanceActionDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta any, timeout time.Duration) diag.Diagnostics {
+	ctx = contextWithPIPollInterval(ctx, meta)
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_instance_action", "create/update")
@@ -210,40 +210,31 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta any, t
 func isWaitForPIInstanceActionStatus(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration, targetStatus, targetHealthStatus string) (any, error) {
 	log.Printf("Waiting for the action to be performed on the instance %s", id)
 
-	stateConf := &retry.StateChangeConf{
+	return waitForPIInstanceState(ctx, client, id, piInstanceWaitConf{
 		Pending:    []string{State_Pending},
 		Target:     []string{targetStatus, State_Error, ""},
-		Refresh:    isPIActionRefreshFunc(client, id, targetStatus, targetHealthStatus),
+		State:      isPIActionRefreshFunc(targetStatus, targetHealthStatus),
 		Delay:      30 * time.Second,
 		MinTimeout: 2 * time.Minute,
 		Timeout:    timeout,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	})
 }
 
-func isPIActionRefreshFunc(client *instance.IBMPIInstanceClient, id, targetStatus, targetHealthStatus string) retry.StateRefreshFunc {
-	return func() (any, string, error) {
+func isPIActionRefreshFunc(targetStatus, targetHealthStatus string) piInstanceStateFunc {
+	return func(pvm *models.PVMInstance) (string, error) {
 		log.Printf("Waiting for the target status to be [ %s ]", targetStatus)
-		pvm, err := client.Get(id)
-		if err != nil {
-			return nil, "", err
-		}
-
 		if strings.ToLower(*pvm.Status) == targetStatus && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == OK) {
 			log.Printf("The health status is now %s", pvm.Health.Status)
-			return pvm, targetStatus, nil
+			return targetStatus, nil
 		}
 
 		if strings.ToLower(*pvm.Status) == State_Error {
 			if pvm.Fault != nil {
-				err = fmt.Errorf("failed to perform the action on the instance: %s", pvm.Fault.Message)
-			} else {
-				err = fmt.Errorf("failed to perform the action on the instance")
+				return *pvm.Status, fmt.Errorf("failed to perform the action on the instance: %s", pvm.Fault.Message)
 			}
-			return pvm, *pvm.Status, err
+			return *pvm.Status, fmt.Errorf("failed to perform the action on the instance")
 		}
 
-		return pvm, State_Pending, nil
+		return State_Pending, nil
 	}
 }
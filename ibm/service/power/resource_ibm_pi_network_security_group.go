@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,8 +19,10 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
@@ -32,6 +35,8 @@ func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		CustomizeDiff: customdiff.Sequence(
@@ -54,6 +59,127 @@ func ResourceIBMPINetworkSecurityGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_Rules: {
+				Description: "The complete set of rules of the network security group. When set, rules that are not in the set are removed from the network security group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Action: {
+							Description:  "The action to take if the rule matches network traffic.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{Allow, Deny}),
+						},
+						Attr_DestinationPort: {
+							Description: "Destination port ranges.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Maximum: {
+										Default:     65535,
+										Description: "The end of the port range, if applicable. If the value is not present then the default value of 65535 will be the maximum port number.",
+										Optional:    true,
+										Type:        schema.TypeInt,
+									},
+									Attr_Minimum: {
+										Default:     1,
+										Description: "The start of the port range, if applicable. If the value is not present then the default value of 1 will be the minimum port number.",
+										Optional:    true,
+										Type:        schema.TypeInt,
+									},
+								},
+							},
+							MaxItems: 1,
+							Optional: true,
+							Type:     schema.TypeList,
+						},
+						Attr_Protocol: {
+							Description: "The protocol of the network traffic.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_ICMPType: {
+										Description:  "If icmp type, a ICMP packet type affected by ICMP rules and if not present then all types are matched.",
+										Optional:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{All, DestinationUnreach, Echo, EchoReply, SourceQuench, TimeExceeded}),
+									},
+									Attr_TCPFlags: {
+										Description: "If tcp type, the list of TCP flags and if not present then all flags are matched.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												Attr_Flag: {
+													Description: "TCP flag.",
+													Required:    true,
+													Type:        schema.TypeString,
+												},
+											},
+										},
+										Optional: true,
+										Type:     schema.TypeSet,
+									},
+									Attr_Type: {
+										Description:  "The protocol of the network traffic.",
+										Required:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{All, ICMP, TCP, UDP}),
+									},
+								},
+							},
+							MaxItems: 1,
+							Required: true,
+							Type:     schema.TypeList,
+						},
+						Attr_Remote: {
+							Description: "The remote network address group or network security group the rule applies to.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_ID: {
+										Description: "The ID of the remote network address group or network security group the rule applies to. Not required for default-network-address-group.",
+										Optional:    true,
+										Type:        schema.TypeString,
+									},
+									Attr_Name: {
+										Description: "The name of the remote network address group the rule applies to, instead of its ID.",
+										Optional:    true,
+										Type:        schema.TypeString,
+									},
+									Attr_Type: {
+										Description:  "The type of remote group the rule applies to.",
+										Required:     true,
+										Type:         schema.TypeString,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{DefaultNAG, NAG, NSG}),
+									},
+								},
+							},
+							MaxItems: 1,
+							Required: true,
+							Type:     schema.TypeList,
+						},
+						Attr_SourcePort: {
+							Description: "Source port ranges.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Maximum: {
+										Default:     65535,
+										Description: "The end of the port range, if applicable. If the value is not present then the default value of 65535 will be the maximum port number.",
+										Optional:    true,
+										Type:        schema.TypeInt,
+									},
+									Attr_Minimum: {
+										Default:     1,
+										Description: "The start of the port range, if applicable. If the value is not present then the default value of 1 will be the minimum port number.",
+										Optional:    true,
+										Type:        schema.TypeInt,
+									},
+								},
+							},
+							MaxItems: 1,
+							Optional: true,
+							Type:     schema.TypeList,
+						},
+					},
+				},
+				Optional: true,
+				Type:     schema.TypeSet,
+			},
 			Arg_UserTags: {
 				Computed:    true,
 				Description: "The user tags associated with this resource.",
@@ -259,6 +385,13 @@ func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.Reso
 	nsgID := *networkSecurityGroup.ID
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, nsgID))
 
+	if _, ok := d.GetOk(Arg_Rules); ok {
+		err = applyIBMPINetworkSecurityGroupRules(ctx, d, sess, cloudInstanceID, nsgID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
 }
 
@@ -316,6 +449,10 @@ func resourceIBMPINetworkSecurityGroupRead(ctx context.Context, d *schema.Resour
 		d.Set(Attr_Rules, []string{})
 	}
 
+	if _, ok := d.GetOk(Arg_Rules); ok {
+		d.Set(Arg_Rules, flattenNetworkSecurityGroupRules(ctx, d, sess, cloudInstanceID, networkSecurityGroup.Rules))
+	}
+
 	return nil
 }
 
@@ -347,6 +484,14 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 			return diag.FromErr(err)
 		}
 	}
+	if d.HasChange(Arg_Rules) {
+		if _, ok := d.GetOk(Arg_Rules); ok {
+			err = applyIBMPINetworkSecurityGroupRules(ctx, d, sess, cloudInstanceID, nsgID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	return resourceIBMPINetworkSecurityGroupRead(ctx, d, meta)
 }
 
@@ -394,3 +539,301 @@ func isIBMPINetworkSecurityGroupDeleteRefreshFunc(client *instance.IBMPINetworkS
 		return nsg, State_Deleting, nil
 	}
 }
+
+// networkSecurityGroupDesiredRule is a rule of pi_rules, with the remote
+// network address group resolved to its ID.
+type networkSecurityGroupDesiredRule struct {
+	body    *models.NetworkSecurityGroupAddRule
+	ruleMap map[string]interface{}
+}
+
+// applyIBMPINetworkSecurityGroupRules makes the rules of the network security
+// group match pi_rules. Rules that are unchanged are kept, and since rules
+// cannot be updated in place, a changed rule is removed and added again.
+// New allow rules are added before any rule is removed, and allow rules are
+// removed last, so that existing flows are never left without an allow rule
+// while the rules are applied.
+func applyIBMPINetworkSecurityGroupRules(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID, nsgID string, timeout time.Duration) error {
+	nsgClient := instance.NewIBMIPINetworkSecurityGroupClient(ctx, sess, cloudInstanceID)
+	networkSecurityGroup, err := nsgClient.Get(nsgID)
+	if err != nil {
+		return err
+	}
+	desired, err := expandNetworkSecurityGroupDesiredRules(ctx, sess, cloudInstanceID, d.Get(Arg_Rules).(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	pending := map[string][]*networkSecurityGroupDesiredRule{}
+	for _, rule := range desired {
+		key := networkSecurityGroupAddRuleKey(rule.body)
+		pending[key] = append(pending[key], rule)
+	}
+	var removeAllow, removeDeny []*models.NetworkSecurityGroupRule
+	for _, rule := range networkSecurityGroup.Rules {
+		key := networkSecurityGroupRuleKey(rule)
+		if len(pending[key]) > 0 {
+			pending[key] = pending[key][1:]
+			continue
+		}
+		if rule.Action != nil && *rule.Action == Allow {
+			removeAllow = append(removeAllow, rule)
+		} else {
+			removeDeny = append(removeDeny, rule)
+		}
+	}
+	var addAllow, addDeny []*models.NetworkSecurityGroupAddRule
+	for _, rule := range desired {
+		key := networkSecurityGroupAddRuleKey(rule.body)
+		if len(pending[key]) == 0 || pending[key][0] != rule {
+			continue
+		}
+		pending[key] = pending[key][1:]
+		if *rule.body.Action == Allow {
+			addAllow = append(addAllow, rule.body)
+		} else {
+			addDeny = append(addDeny, rule.body)
+		}
+	}
+	log.Printf("[DEBUG] network security group %s rules: adding %d allow and %d deny, removing %d allow and %d deny", nsgID, len(addAllow), len(addDeny), len(removeAllow), len(removeDeny))
+
+	if err := addIBMPINetworkSecurityGroupRules(ctx, nsgClient, nsgID, addAllow, timeout); err != nil {
+		return err
+	}
+	if err := removeIBMPINetworkSecurityGroupRules(ctx, nsgClient, nsgID, removeDeny, timeout); err != nil {
+		return err
+	}
+	if err := addIBMPINetworkSecurityGroupRules(ctx, nsgClient, nsgID, addDeny, timeout); err != nil {
+		return err
+	}
+	return removeIBMPINetworkSecurityGroupRules(ctx, nsgClient, nsgID, removeAllow, timeout)
+}
+
+func addIBMPINetworkSecurityGroupRules(ctx context.Context, client *instance.IBMPINetworkSecurityGroupClient, nsgID string, rules []*models.NetworkSecurityGroupAddRule, timeout time.Duration) error {
+	for _, rule := range rules {
+		networkSecurityGroupRule, err := client.AddRule(nsgID, rule)
+		if err != nil {
+			return fmt.Errorf("error adding rule to network security group %s: %w", nsgID, err)
+		}
+		_, err = isWaitForIBMPINetworkSecurityGroupRuleAdd(ctx, client, nsgID, *networkSecurityGroupRule.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeIBMPINetworkSecurityGroupRules(ctx context.Context, client *instance.IBMPINetworkSecurityGroupClient, nsgID string, rules []*models.NetworkSecurityGroupRule, timeout time.Duration) error {
+	for _, rule := range rules {
+		err := client.DeleteRule(nsgID, *rule.ID)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+				continue
+			}
+			return fmt.Errorf("error removing rule %s from network security group %s: %w", *rule.ID, nsgID, err)
+		}
+		_, err = isWaitForIBMPINetworkSecurityGroupRuleRemove(ctx, client, nsgID, *rule.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandNetworkSecurityGroupDesiredRules builds the rules to add from
+// pi_rules, looking up the network address groups referenced by name.
+func expandNetworkSecurityGroupDesiredRules(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string, ruleMaps []interface{}) ([]*networkSecurityGroupDesiredRule, error) {
+	var nagIDs map[string]string
+	rules := make([]*networkSecurityGroupDesiredRule, 0, len(ruleMaps))
+	for _, v := range ruleMaps {
+		ruleMap := v.(map[string]interface{})
+		action := ruleMap[Attr_Action].(string)
+		body := &models.NetworkSecurityGroupAddRule{
+			Action: &action,
+		}
+		if protocols := ruleMap[Attr_Protocol].([]interface{}); len(protocols) > 0 && protocols[0] != nil {
+			body.Protocol = networkSecurityGroupRuleMapToProtocol(protocols[0].(map[string]interface{}))
+		}
+		if body.Protocol != nil && (body.Protocol.Type == All || body.Protocol.Type == ICMP) {
+			if len(ruleMap[Attr_DestinationPort].([]interface{})) > 0 || len(ruleMap[Attr_SourcePort].([]interface{})) > 0 {
+				return nil, fmt.Errorf("destination_port and source_port are not allowed with protocol value of %s or %s", All, ICMP)
+			}
+		}
+		if ports := ruleMap[Attr_DestinationPort].([]interface{}); len(ports) > 0 && ports[0] != nil {
+			body.DestinationPort = networkSecurityGroupRuleMapToPort(ports[0].(map[string]interface{}))
+		}
+		if ports := ruleMap[Attr_SourcePort].([]interface{}); len(ports) > 0 && ports[0] != nil {
+			body.SourcePort = networkSecurityGroupRuleMapToPort(ports[0].(map[string]interface{}))
+		}
+		if remotes := ruleMap[Attr_Remote].([]interface{}); len(remotes) > 0 && remotes[0] != nil {
+			remote := remotes[0].(map[string]interface{})
+			body.Remote = networkSecurityGroupRuleMapToRemote(remote)
+			if name := remote[Attr_Name].(string); name != "" {
+				if body.Remote.ID != "" {
+					return nil, fmt.Errorf("only one of id and name can be set for the remote of a rule, got id %s and name %s", body.Remote.ID, name)
+				}
+				if body.Remote.Type != NAG {
+					return nil, fmt.Errorf("the remote name %s is only supported with remote type %s", name, NAG)
+				}
+				if nagIDs == nil {
+					var err error
+					nagIDs, err = networkAddressGroupIDsByName(ctx, sess, cloudInstanceID)
+					if err != nil {
+						return nil, err
+					}
+				}
+				id, ok := nagIDs[name]
+				if !ok {
+					return nil, fmt.Errorf("network address group %s not found in workspace %s", name, cloudInstanceID)
+				}
+				if id == "" {
+					return nil, fmt.Errorf("more than one network address group is named %s in workspace %s, use the ID of the network address group instead", name, cloudInstanceID)
+				}
+				body.Remote.ID = id
+			}
+		}
+		rules = append(rules, &networkSecurityGroupDesiredRule{body: body, ruleMap: ruleMap})
+	}
+	return rules, nil
+}
+
+// networkAddressGroupIDsByName maps the names of the network address groups
+// of the workspace to their IDs. Names that are not unique map to "".
+func networkAddressGroupIDsByName(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (map[string]string, error) {
+	nagClient := instance.NewIBMPINetworkAddressGroupClient(ctx, sess, cloudInstanceID)
+	networkAddressGroups, err := nagClient.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, nag := range networkAddressGroups.NetworkAddressGroups {
+		if nag.Name == nil || nag.ID == nil {
+			continue
+		}
+		if _, ok := ids[*nag.Name]; ok {
+			ids[*nag.Name] = ""
+			continue
+		}
+		ids[*nag.Name] = *nag.ID
+	}
+	return ids, nil
+}
+
+// flattenNetworkSecurityGroupRules returns pi_rules for the rules of the
+// network security group. A rule that matches a configured rule keeps its
+// configured form, so that remotes referenced by name do not show a diff.
+func flattenNetworkSecurityGroupRules(ctx context.Context, d *schema.ResourceData, sess *ibmpisession.IBMPISession, cloudInstanceID string, rules []*models.NetworkSecurityGroupRule) []map[string]interface{} {
+	desired, err := expandNetworkSecurityGroupDesiredRules(ctx, sess, cloudInstanceID, d.Get(Arg_Rules).(*schema.Set).List())
+	if err != nil {
+		// The rules are read as they are, and the error is reported when
+		// they are applied.
+		log.Printf("[WARN] Error on resolving pi_rules of network security group %s: %s", d.Id(), err)
+		desired = nil
+	}
+	pending := map[string][]*networkSecurityGroupDesiredRule{}
+	for _, rule := range desired {
+		key := networkSecurityGroupAddRuleKey(rule.body)
+		pending[key] = append(pending[key], rule)
+	}
+
+	ruleMaps := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		key := networkSecurityGroupRuleKey(rule)
+		if len(pending[key]) > 0 {
+			ruleMaps = append(ruleMaps, pending[key][0].ruleMap)
+			pending[key] = pending[key][1:]
+			continue
+		}
+
+		ruleMap := map[string]interface{}{}
+		if rule.Action != nil {
+			ruleMap[Attr_Action] = *rule.Action
+		}
+		if rule.Protocol != nil {
+			protocolMap := map[string]interface{}{
+				Attr_Type: rule.Protocol.Type,
+			}
+			if rule.Protocol.IcmpType != nil {
+				protocolMap[Attr_ICMPType] = *rule.Protocol.IcmpType
+			}
+			tcpFlags := []interface{}{}
+			for _, tcpFlag := range rule.Protocol.TCPFlags {
+				tcpFlags = append(tcpFlags, map[string]interface{}{Attr_Flag: tcpFlag.Flag})
+			}
+			protocolMap[Attr_TCPFlags] = tcpFlags
+			ruleMap[Attr_Protocol] = []interface{}{protocolMap}
+		}
+		if rule.Remote != nil {
+			ruleMap[Attr_Remote] = []interface{}{map[string]interface{}{
+				Attr_ID:   rule.Remote.ID,
+				Attr_Type: rule.Remote.Type,
+			}}
+		}
+		if rule.DestinationPort != nil {
+			ruleMap[Attr_DestinationPort] = []interface{}{map[string]interface{}{
+				Attr_Maximum: int(rule.DestinationPort.Maximum),
+				Attr_Minimum: int(rule.DestinationPort.Minimum),
+			}}
+		}
+		if rule.SourcePort != nil {
+			ruleMap[Attr_SourcePort] = []interface{}{map[string]interface{}{
+				Attr_Maximum: int(rule.SourcePort.Maximum),
+				Attr_Minimum: int(rule.SourcePort.Minimum),
+			}}
+		}
+		ruleMaps = append(ruleMaps, ruleMap)
+	}
+	return ruleMaps
+}
+
+func networkSecurityGroupRuleKey(rule *models.NetworkSecurityGroupRule) string {
+	action := ""
+	if rule.Action != nil {
+		action = *rule.Action
+	}
+	return networkSecurityGroupRuleKeyOf(action, rule.Protocol, rule.Remote, rule.DestinationPort, rule.SourcePort)
+}
+
+func networkSecurityGroupAddRuleKey(rule *models.NetworkSecurityGroupAddRule) string {
+	return networkSecurityGroupRuleKeyOf(*rule.Action, rule.Protocol, rule.Remote, rule.DestinationPort, rule.SourcePort)
+}
+
+// networkSecurityGroupRuleKeyOf returns a key identifying what a rule matches,
+// so that equivalent rules have the same key. Ports default to the full
+// range, and ICMP types default to all.
+func networkSecurityGroupRuleKeyOf(action string, protocol *models.NetworkSecurityGroupRuleProtocol, remote *models.NetworkSecurityGroupRuleRemote, destinationPort, sourcePort *models.NetworkSecurityGroupRulePort) string {
+	protocolType, icmpType, tcpFlags := All, "", []string{}
+	if protocol != nil {
+		if protocol.Type != "" {
+			protocolType = protocol.Type
+		}
+		if protocolType == ICMP {
+			icmpType = All
+			if protocol.IcmpType != nil && *protocol.IcmpType != "" {
+				icmpType = *protocol.IcmpType
+			}
+		}
+		for _, tcpFlag := range protocol.TCPFlags {
+			tcpFlags = append(tcpFlags, tcpFlag.Flag)
+		}
+		sort.Strings(tcpFlags)
+	}
+	remoteType, remoteID := "", ""
+	if remote != nil {
+		remoteType, remoteID = remote.Type, remote.ID
+	}
+	portKey := func(port *models.NetworkSecurityGroupRulePort) string {
+		if port == nil {
+			return "1-65535"
+		}
+		minimum, maximum := port.Minimum, port.Maximum
+		if minimum == 0 {
+			minimum = 1
+		}
+		if maximum == 0 {
+			maximum = 65535
+		}
+		return fmt.Sprintf("%d-%d", minimum, maximum)
+	}
+	return strings.Join([]string{action, protocolType, icmpType, strings.Join(tcpFlags, ","), remoteType, remoteID, portKey(destinationPort), portKey(sourcePort)}, "|")
+}
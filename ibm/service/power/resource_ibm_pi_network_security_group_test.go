@@ -54,6 +54,72 @@ func testAccCheckIBMPINetworkSecurityGroupConfigBasic(name string) string {
 		}`, acc.Pi_cloud_instance_id, name)
 }
 
+func TestAccIBMPINetworkSecurityGroupRules(t *testing.T) {
+	name := fmt.Sprintf("tf-nsg-name-%d", acctest.RandIntRange(10, 100))
+	nagName := fmt.Sprintf("tf-nag-name-%d", acctest.RandIntRange(10, 100))
+	nsgRes := "ibm_pi_network_security_group.network_security_group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkSecurityGroupConfigRules(name, nagName, "22"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMPINetworkSecurityGroupExists(nsgRes),
+					resource.TestCheckResourceAttr(nsgRes, "pi_rules.#", "2"),
+					resource.TestCheckResourceAttr(nsgRes, "rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPINetworkSecurityGroupConfigRules(name, nagName, "443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(nsgRes, "pi_rules.#", "2"),
+					resource.TestCheckResourceAttr(nsgRes, "rules.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkSecurityGroupConfigRules(name, nagName, port string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_network_address_group" "network_address_group" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_name = "%[3]s"
+		}
+
+		resource "ibm_pi_network_security_group" "network_security_group" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_name = "%[2]s"
+			pi_rules {
+				action = "allow"
+				protocol {
+					type = "icmp"
+					icmp_type = "echo"
+				}
+				remote {
+					type = "default-network-address-group"
+				}
+			}
+			pi_rules {
+				action = "allow"
+				destination_port {
+					minimum = %[4]s
+					maximum = %[4]s
+				}
+				protocol {
+					type = "tcp"
+				}
+				remote {
+					name = ibm_pi_network_address_group.network_address_group.pi_name
+					type = "network-address-group"
+				}
+			}
+		}`, acc.Pi_cloud_instance_id, name, nagName, port)
+}
+
 func testAccCheckIBMPINetworkSecurityGroupExists(n string) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
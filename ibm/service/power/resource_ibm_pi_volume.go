@@ -41,6 +41,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			piCapacityCheckCustomizeDiff(ResourceIBMPIVolume, piVolumeCapacityRequest),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +83,12 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Optional:         true,
 				Type:             schema.TypeList,
 			},
+			Arg_CapacityCheck: {
+				Description:  "Check at plan time that the workspace has free capacity for all planned instances and volumes that set pi_capacity_check. With warn the shortage is logged and reported as a warning on create, with fail the plan fails.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Warn, Fail}),
+			},
 			Arg_CloudInstanceID: {
 				Description:  "The GUID of the service instance associated with an account.",
 				ForceNew:     true,
@@ -251,6 +258,7 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("volume %s", d.Get(Arg_VolumeName).(string)))
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "create")
@@ -347,7 +355,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceIBMPIVolumeRead(ctx, d, meta)
+	return append(piCapacityCheckWarnings(d), resourceIBMPIVolumeRead(ctx, d, meta)...)
 }
 
 func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -421,6 +429,7 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("volume %s", d.Get(Arg_VolumeName).(string)))
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "update")
@@ -497,6 +506,7 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defer piCapacityRelease(d, fmt.Sprintf("volume %s", d.Get(Arg_VolumeName).(string)))
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "delete")
@@ -0,0 +1,676 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// volumeSetIndex is replaced by the index of the volume in the name template.
+const volumeSetIndex = "{index}"
+
+var regexpVolumeSetNameTemplate = regexp.MustCompile(regexp.QuoteMeta(volumeSetIndex))
+
+func ResourceIBMPIVolumeSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeSetCreate,
+		ReadContext:   resourceIBMPIVolumeSetRead,
+		UpdateContext: resourceIBMPIVolumeSetUpdate,
+		DeleteContext: resourceIBMPIVolumeSetDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
+				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
+			},
+			resourceIBMPIVolumeSetCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceID: {
+				Description: "The ID of the PVM instance to attach the volumes to.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_UserTags: {
+				Computed:    true,
+				Description: "The user tags attached to the volumes.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_VolumeCount: {
+				Description:  "The number of volumes in the set. Volumes are added or removed at the end of the set.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_VolumeNameTemplate: {
+				Description:  "The name template of the volumes, where {index} is replaced by the index of the volume, starting at 1.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexpVolumeSetNameTemplate, fmt.Sprintf("must contain %s", volumeSetIndex)),
+			},
+			Arg_VolumePool: {
+				Computed:    true,
+				Description: "The volume pool where the volumes are created.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_VolumeShareable: {
+				Description: "If set to true, the volumes can be shared across Power Systems Virtual Server instances.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_VolumeSize: {
+				Description: "The size of each volume in GB. The size can only be increased.",
+				Required:    true,
+				Type:        schema.TypeFloat,
+			},
+			Arg_VolumeType: {
+				Computed:    true,
+				Description: "The type of service offering of the volumes.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+
+			// Attributes
+			Attr_VolumeIDs: {
+				Computed:    true,
+				Description: "The IDs of the volumes, ordered by index.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_Volumes: {
+				Computed:    true,
+				Description: "The volumes of the set, ordered by index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Attached: {
+							Computed:    true,
+							Description: "Indicates if the volume is attached to the instance.",
+							Type:        schema.TypeBool,
+						},
+						Attr_CRN: {
+							Computed:    true,
+							Description: "The CRN of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The ID of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_Index: {
+							Computed:    true,
+							Description: "The index of the volume in the set.",
+							Type:        schema.TypeInt,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_Size: {
+							Computed:    true,
+							Description: "The size of the volume in GB.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_WWN: {
+							Computed:    true,
+							Description: "The world wide name of the volume.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeSetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume_set", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	err = ensureIBMPIVolumeSet(ctx, d, client, d.Timeout(schema.TimeoutCreate))
+	if err != nil && d.Id() != "" {
+		return flex.PartialCreateDiagnostics(diag.Diagnostic{
+			Summary: fmt.Sprintf("Volume set %s is incomplete", d.Get(Arg_VolumeNameTemplate).(string)),
+			Detail:  fmt.Sprintf("%s. The volumes created so far are kept in state and the next apply creates and attaches the missing volumes.", err),
+		}, func() diag.Diagnostics {
+			return resourceIBMPIVolumeSetRead(ctx, d, meta)
+		})
+	}
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ensureIBMPIVolumeSet failed: %s", err.Error()), "ibm_pi_volume_set", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	return resourceIBMPIVolumeSetRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeSetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume_set", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_volume_set", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	pvmInstanceID := d.Get(Arg_InstanceID).(string)
+	volumes := []map[string]any{}
+	volumeIDs := []string{}
+	for _, member := range volumeSetMembers(d) {
+		vol, err := client.Get(member.id)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+				log.Printf("[WARNING] volume %s of the volume set was not found or removed outside of terraform", member.id)
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_volume_set", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		volume := map[string]any{
+			Attr_Attached: pvmInstanceID != "" && flex.StringContains(vol.PvmInstanceIDs, pvmInstanceID),
+			Attr_CRN:      string(vol.Crn),
+			Attr_ID:       member.id,
+			Attr_Index:    member.index,
+			Attr_Status:   vol.State,
+			Attr_WWN:      vol.Wwn,
+		}
+		if vol.Name != nil {
+			volume[Attr_Name] = *vol.Name
+		}
+		if vol.Size != nil {
+			volume[Attr_Size] = *vol.Size
+		}
+		if len(volumes) == 0 {
+			d.Set(Arg_VolumePool, vol.VolumePool)
+			d.Set(Arg_VolumeType, vol.DiskType)
+			if vol.Crn != "" {
+				tags, err := flex.GetGlobalTagsUsingCRN(meta, string(vol.Crn), "", UserTagType)
+				if err != nil {
+					log.Printf("Error on get of volume (%s) pi_user_tags: %s", member.id, err)
+				}
+				d.Set(Arg_UserTags, tags)
+			}
+		}
+		volumes = append(volumes, volume)
+		volumeIDs = append(volumeIDs, member.id)
+	}
+	if len(volumes) == 0 {
+		log.Printf("[WARNING] volume set %s has no volumes left, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_VolumeIDs, volumeIDs)
+	d.Set(Attr_Volumes, volumes)
+
+	return nil
+}
+
+func resourceIBMPIVolumeSetUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	if d.HasChange(Arg_VolumeSize) {
+		oldSize, newSize := d.GetChange(Arg_VolumeSize)
+		if newSize.(float64) < oldSize.(float64) {
+			err = flex.FmtErrorf("the size of the volumes cannot be decreased from %v to %v", oldSize, newSize)
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("operation failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	err = ensureIBMPIVolumeSet(ctx, d, client, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ensureIBMPIVolumeSet failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if d.HasChange(Arg_VolumeSize) {
+		size := d.Get(Arg_VolumeSize).(float64)
+		for _, member := range volumeSetMembers(d) {
+			vol, err := client.Get(member.id)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			if vol.Size != nil && *vol.Size >= size {
+				continue
+			}
+			body := &models.UpdateVolume{
+				Name:      vol.Name,
+				Shareable: vol.Shareable,
+				Size:      size,
+			}
+			_, err = client.UpdateVolume(member.id, body)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateVolume failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			_, err = isWaitForIBMPIVolumeAvailable(ctx, client, member.id, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForIBMPIVolumeAvailable failed: %s", err.Error()), "ibm_pi_volume_set", "update")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	if d.HasChange(Arg_UserTags) {
+		oldList, newList := d.GetChange(Arg_UserTags)
+		for _, volume := range d.Get(Attr_Volumes).([]any) {
+			crn := volume.(map[string]any)[Attr_CRN].(string)
+			if crn == "" {
+				continue
+			}
+			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn, "", UserTagType)
+			if err != nil {
+				log.Printf("Error on update of pi volume (%s) pi_user_tags: %s", volume.(map[string]any)[Attr_ID], err)
+			}
+		}
+	}
+
+	return resourceIBMPIVolumeSetRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeSetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_volume_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumeIDs := []string{}
+	for _, member := range volumeSetMembers(d) {
+		volumeIDs = append(volumeIDs, member.id)
+	}
+	err = removeIBMPIVolumeSetVolumes(ctx, client, d.Get(Arg_InstanceID).(string), volumeIDs, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("removeIBMPIVolumeSetVolumes failed: %s", err.Error()), "ibm_pi_volume_set", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIVolumeSetCustomizeDiff plans an update of the volumes when
+// volumes are added or removed, or when volumes are missing or not attached
+// to the instance.
+func resourceIBMPIVolumeSetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	volumes := diff.Get(Attr_Volumes).([]any)
+	incomplete := diff.HasChange(Arg_VolumeCount) || len(volumes) != diff.Get(Arg_VolumeCount).(int)
+	if diff.Get(Arg_InstanceID).(string) != "" {
+		for _, volume := range volumes {
+			if attached, _ := volume.(map[string]any)[Attr_Attached].(bool); !attached {
+				incomplete = true
+			}
+		}
+	}
+	if !incomplete {
+		return nil
+	}
+	for _, key := range []string{Attr_VolumeIDs, Attr_Volumes} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// volumeSetMember is a volume of the set and its index.
+type volumeSetMember struct {
+	index int
+	id    string
+}
+
+// volumeSetMembers returns the volumes of the set recorded in the state,
+// ordered by index.
+func volumeSetMembers(d *schema.ResourceData) []volumeSetMember {
+	members := []volumeSetMember{}
+	for _, v := range d.Get(Attr_Volumes).([]any) {
+		volume := v.(map[string]any)
+		members = append(members, volumeSetMember{
+			index: volume[Attr_Index].(int),
+			id:    volume[Attr_ID].(string),
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].index < members[j].index })
+	return members
+}
+
+// setVolumeSetMembers records the volumes of the set, so that volumes created
+// before a failure are not created again on the next apply.
+func setVolumeSetMembers(d *schema.ResourceData, members []volumeSetMember) {
+	sort.Slice(members, func(i, j int) bool { return members[i].index < members[j].index })
+	volumes := []map[string]any{}
+	volumeIDs := []string{}
+	for _, member := range members {
+		volumes = append(volumes, map[string]any{
+			Attr_ID:    member.id,
+			Attr_Index: member.index,
+		})
+		volumeIDs = append(volumeIDs, member.id)
+	}
+	d.Set(Attr_Volumes, volumes)
+	d.Set(Attr_VolumeIDs, volumeIDs)
+}
+
+// ensureIBMPIVolumeSet removes the volumes beyond pi_volume_count, creates
+// the missing volumes and attaches the volumes that are not attached to the
+// instance.
+func ensureIBMPIVolumeSet(ctx context.Context, d *schema.ResourceData, client *instance.IBMPIVolumeClient, timeout time.Duration) error {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	pvmInstanceID := d.Get(Arg_InstanceID).(string)
+	count := d.Get(Arg_VolumeCount).(int)
+
+	members := []volumeSetMember{}
+	existing := map[int]bool{}
+	removeIDs := []string{}
+	for _, member := range volumeSetMembers(d) {
+		if member.index > count {
+			removeIDs = append(removeIDs, member.id)
+			continue
+		}
+		members = append(members, member)
+		existing[member.index] = true
+	}
+	if len(removeIDs) > 0 {
+		err := removeIBMPIVolumeSetVolumes(ctx, client, pvmInstanceID, removeIDs, timeout)
+		if err != nil {
+			return err
+		}
+		setVolumeSetMembers(d, members)
+	}
+
+	size := d.Get(Arg_VolumeSize).(float64)
+	shareable := d.Get(Arg_VolumeShareable).(bool)
+	var userTags []string
+	if v, ok := d.GetOk(Arg_UserTags); ok {
+		userTags = flex.FlattenSet(v.(*schema.Set))
+	}
+	createdIDs := []string{}
+	for index := 1; index <= count; index++ {
+		if existing[index] {
+			continue
+		}
+		name := strings.ReplaceAll(d.Get(Arg_VolumeNameTemplate).(string), volumeSetIndex, strconv.Itoa(index))
+		body := &models.CreateDataVolume{
+			Name:      &name,
+			Shareable: &shareable,
+			Size:      &size,
+			UserTags:  userTags,
+		}
+		if v, ok := d.GetOk(Arg_VolumeType); ok {
+			body.DiskType = v.(string)
+		}
+		if v, ok := d.GetOk(Arg_VolumePool); ok {
+			body.VolumePool = v.(string)
+		}
+		vol, err := client.CreateVolume(body)
+		if err != nil {
+			setVolumeSetMembers(d, members)
+			return fmt.Errorf("error creating volume %s: %w", name, err)
+		}
+		members = append(members, volumeSetMember{index: index, id: *vol.VolumeID})
+		createdIDs = append(createdIDs, *vol.VolumeID)
+		if d.Id() == "" {
+			d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vol.VolumeID))
+		}
+		setVolumeSetMembers(d, members)
+	}
+	for _, volumeID := range createdIDs {
+		_, err := isWaitForIBMPIVolumeAvailable(ctx, client, volumeID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pvmInstanceID == "" {
+		return nil
+	}
+	attachIDs, err := volumeSetVolumesNotAttached(client, pvmInstanceID, volumeSetMembers(d))
+	if err != nil {
+		return err
+	}
+	return attachIBMPIVolumeSetVolumes(ctx, client, pvmInstanceID, attachIDs, timeout)
+}
+
+// volumeSetVolumesNotAttached returns the IDs of the volumes that are not
+// attached to the instance, ordered by index.
+func volumeSetVolumesNotAttached(client *instance.IBMPIVolumeClient, pvmInstanceID string, members []volumeSetMember) ([]string, error) {
+	instanceVolumes, err := client.GetAllInstanceVolumes(pvmInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	attached := map[string]bool{}
+	for _, vol := range instanceVolumes.Volumes {
+		if vol.VolumeID != nil {
+			attached[*vol.VolumeID] = true
+		}
+	}
+	volumeIDs := []string{}
+	for _, member := range members {
+		if !attached[member.id] {
+			volumeIDs = append(volumeIDs, member.id)
+		}
+	}
+	return volumeIDs, nil
+}
+
+// attachIBMPIVolumeSetVolumes attaches the volumes to the instance in a single
+// bulk request. When the bulk request is not accepted, the volumes that are
+// still not attached are attached one at a time, in order. Requests are
+// retried while the instance is busy.
+func attachIBMPIVolumeSetVolumes(ctx context.Context, client *instance.IBMPIVolumeClient, pvmInstanceID string, volumeIDs []string, timeout time.Duration) error {
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+	err := isWaitForIBMPIVolumeSetRequestAccepted(ctx, func() error {
+		_, err := client.BulkVolumeAttach(pvmInstanceID, &models.VolumesAttach{VolumeIDs: volumeIDs})
+		return err
+	}, timeout)
+	if err == nil {
+		for _, volumeID := range volumeIDs {
+			_, err := isWaitForIBMPIVolumeAttachAvailable(ctx, client, volumeID, pvmInstanceID, timeout)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] Bulk attach of volumes %v to instance %s failed, attaching the volumes one at a time: %s", volumeIDs, pvmInstanceID, err)
+	members := make([]volumeSetMember, 0, len(volumeIDs))
+	for i, volumeID := range volumeIDs {
+		members = append(members, volumeSetMember{index: i, id: volumeID})
+	}
+	volumeIDs, err = volumeSetVolumesNotAttached(client, pvmInstanceID, members)
+	if err != nil {
+		return err
+	}
+	for _, volumeID := range volumeIDs {
+		err := isWaitForIBMPIVolumeSetRequestAccepted(ctx, func() error {
+			return client.Attach(pvmInstanceID, volumeID)
+		}, timeout)
+		if err != nil {
+			return err
+		}
+		_, err = isWaitForIBMPIVolumeAttachAvailable(ctx, client, volumeID, pvmInstanceID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeIBMPIVolumeSetVolumes detaches the volumes from the instance in a
+// single bulk request, then deletes them.
+func removeIBMPIVolumeSetVolumes(ctx context.Context, client *instance.IBMPIVolumeClient, pvmInstanceID string, volumeIDs []string, timeout time.Duration) error {
+	if len(volumeIDs) == 0 {
+		return nil
+	}
+	if pvmInstanceID != "" {
+		instanceVolumes, err := client.GetAllInstanceVolumes(pvmInstanceID)
+		if err != nil && !strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			return err
+		}
+		detachIDs := []string{}
+		if instanceVolumes != nil {
+			for _, vol := range instanceVolumes.Volumes {
+				if vol.VolumeID != nil && flex.StringContains(volumeIDs, *vol.VolumeID) {
+					detachIDs = append(detachIDs, *vol.VolumeID)
+				}
+			}
+		}
+		if len(detachIDs) > 0 {
+			err = isWaitForIBMPIVolumeSetRequestAccepted(ctx, func() error {
+				_, err := client.BulkVolumeDetach(pvmInstanceID, &models.VolumesDetach{VolumeIDs: detachIDs})
+				return err
+			}, timeout)
+			if err != nil {
+				return err
+			}
+			for _, volumeID := range detachIDs {
+				_, err = isWaitForIBMPIVolumeDetach(ctx, client, volumeID, pvmInstanceID, timeout)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	_, err := client.BulkVolumeDelete(&models.VolumesDelete{VolumeIDs: volumeIDs})
+	if err != nil {
+		return err
+	}
+	for _, volumeID := range volumeIDs {
+		_, err = isWaitForIBMPIVolumeDeleted(ctx, client, volumeID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isWaitForIBMPIVolumeSetRequestAccepted sends the request until it is
+// accepted, retrying while the instance is busy with another operation.
+func isWaitForIBMPIVolumeSetRequestAccepted(ctx context.Context, request func() error, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Retry},
+		Target:  []string{State_Accepted},
+		Refresh: func() (any, string, error) {
+			err := request()
+			if err != nil {
+				if isIBMPIInstanceBusyError(err) {
+					log.Printf("[DEBUG] The instance is busy, retrying the request: %s", err)
+					return State_Retry, State_Retry, nil
+				}
+				return nil, "", err
+			}
+			return State_Accepted, State_Accepted, nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// isIBMPIInstanceBusyError reports whether the request was rejected with a
+// conflict because another operation is in progress on the instance.
+func isIBMPIInstanceBusyError(err error) bool {
+	var apiErr interface{ IsCode(int) bool }
+	return errors.As(err, &apiErr) && apiErr.IsCode(http.StatusConflict)
+}
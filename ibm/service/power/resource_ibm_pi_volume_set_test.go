@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIVolumeSetBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-set-%d", acctest.RandIntRange(10, 100))
+	volumeSetRes := "ibm_pi_volume_set.power_volume_set"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeSetConfig(name, 3, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(volumeSetRes, "volume_ids.#", "3"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.0.name", name+"-1"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.2.name", name+"-3"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.0.attached", "true"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.2.attached", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeSetConfig(name, 2, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(volumeSetRes, "volume_ids.#", "2"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.1.name", name+"-2"),
+					resource.TestCheckResourceAttr(volumeSetRes, "volumes.1.size", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeSetConfig(name string, count, size int) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volume_set" "power_volume_set" {
+			pi_cloud_instance_id    = "%[1]s"
+			pi_instance_id          = "%[2]s"
+			pi_volume_count         = %[4]d
+			pi_volume_name_template = "%[3]s-{index}"
+			pi_volume_size          = %[5]d
+			pi_volume_type          = "tier3"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_id, name, count, size)
+}
+
+func testAccCheckIBMPIVolumeSetDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_volume_set" {
+			continue
+		}
+		cloudInstanceID, _, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIVolumeClient(context.Background(), sess, cloudInstanceID)
+		for key, volumeID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "volume_ids.") || key == "volume_ids.#" {
+				continue
+			}
+			_, err := client.Get(volumeID)
+			if err == nil {
+				return fmt.Errorf("PI Volume still exists: %s", volumeID)
+			}
+		}
+	}
+
+	return nil
+}
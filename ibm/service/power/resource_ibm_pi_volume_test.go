@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -305,3 +306,38 @@ func testAccCheckIBMPIVolumeUserTagsConfig(name string, userTagsString string) s
 			pi_user_tags            = %[3]s
 		}`, name, acc.Pi_cloud_instance_id, userTagsString)
 }
+
+func TestAccIBMPIVolumeCapacityCheck(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
+	volumeRes := "ibm_pi_volume.power_volume"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIVolumeCapacityCheckConfig(name, 1000000000),
+				ExpectError: regexp.MustCompile("insufficient capacity"),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeCapacityCheckConfig(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists(volumeRes),
+					resource.TestCheckResourceAttr(volumeRes, "pi_capacity_check", "fail"),
+					resource.TestCheckResourceAttr(volumeRes, "pi_volume_size", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeCapacityCheckConfig(name string, size int) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volume" "power_volume" {
+			pi_capacity_check    	= "fail"
+			pi_cloud_instance_id	= "%[2]s"
+			pi_volume_name       	= "%[1]s"
+			pi_volume_size       	= %[3]d
+			pi_volume_type       	= "tier1"
+		}`, name, acc.Pi_cloud_instance_id, size)
+}
@@ -0,0 +1,1009 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Bootstrap steps, in the order they are run. Delete runs them in reverse order.
+const (
+	bootstrapStepSSHKeys                  = "ssh_keys"
+	bootstrapStepNetworks                 = "networks"
+	bootstrapStepCloudConnection          = "cloud_connection"
+	bootstrapStepDhcp                     = "dhcp"
+	bootstrapStepTransitGatewayConnection = "transit_gateway_connection"
+	bootstrapStepImages                   = "images"
+)
+
+func ResourceIBMPIWorkspaceBootstrap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIWorkspaceBootstrapCreate,
+		ReadContext:   resourceIBMPIWorkspaceBootstrapRead,
+		UpdateContext: resourceIBMPIWorkspaceBootstrapUpdate,
+		DeleteContext: resourceIBMPIWorkspaceBootstrapDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
+				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
+			},
+			resourceIBMPIWorkspaceBootstrapCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CatalogImageIDs: {
+				Description: "The IDs of the catalog images to import in the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ForceNew:    true,
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_CloudConnection: {
+				ConflictsWith: []string{Arg_TransitGatewayID},
+				Description:   "The cloud connection to create. The private networks of the workspace are attached to it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_GlobalRouting: {
+							Default:     false,
+							Description: "Enable global routing for the cloud connection.",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						Attr_Metered: {
+							Default:     false,
+							Description: "Enable metered for the cloud connection.",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						Attr_Name: {
+							Description:  "The name of the cloud connection.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Attr_Speed: {
+							Description:  "The speed of the cloud connection, in megabits per second.",
+							Required:     true,
+							Type:         schema.TypeInt,
+							ValidateFunc: validate.ValidateAllowedIntValues([]int{50, 100, 200, 500, 1000, 2000, 5000, 10000}),
+						},
+						Attr_TransitEnabled: {
+							Default:     false,
+							Description: "Enable transit gateway for the cloud connection.",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+					},
+				},
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_Datacenter: {
+				Description:  "Target location or environment to create the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Dhcp: {
+				Description: "The DHCP server to create, providing a private network with outbound access.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CIDR: {
+							Description: "The CIDR of the DHCP private network.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_DNSServer: {
+							Description: "The DNS server of the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Description: "The name of the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_SnatEnabled: {
+							Default:     true,
+							Description: "Indicates if SNAT is enabled for the DHCP service.",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+					},
+				},
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_Name: {
+				Description:  "A descriptive name used to identify the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Networks: {
+				Description: "The networks to create in the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CIDR: {
+							Description: "The CIDR of the network, required for `vlan` networks.",
+							Optional:    true,
+							Type:        schema.TypeString,
+						},
+						Attr_DNS: {
+							Description: "The DNS servers of the network.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Type:        schema.TypeList,
+						},
+						Attr_Name: {
+							Description:  "The name of the network. Must be unique within the bootstrap.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Attr_Type: {
+							Description:  "The type of the network. Valid values are `pub-vlan`, and `vlan`.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{PubVlan, Vlan}),
+						},
+					},
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_Plan: {
+				Default:      Public,
+				Description:  "Plan associated with the offering; Valid values are public or private.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Private, Public}),
+			},
+			Arg_ResourceGroupID: {
+				Description:  "The ID of the resource group where you want to create the workspace.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SSHKeys: {
+				Description: "The SSH keys to create in the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Name: {
+							Description:  "The name of the SSH key. Must be unique within the bootstrap.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Attr_SSHKey: {
+							Description:  "The public SSH key value.",
+							Required:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeList,
+			},
+			Arg_TransitGatewayID: {
+				ConflictsWith: []string{Arg_CloudConnection},
+				Description:   "The ID of the transit gateway to connect the workspace to.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_UserTags: {
+				Computed:    true,
+				Description: "List of user tags attached to the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_CloudConnectionID: {
+				Computed:    true,
+				Description: "The ID of the cloud connection.",
+				Type:        schema.TypeString,
+			},
+			Attr_CRN: {
+				Computed:    true,
+				Description: "The CRN of the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_DhcpID: {
+				Computed:    true,
+				Description: "The ID of the DHCP server.",
+				Type:        schema.TypeString,
+			},
+			Attr_ImageIDs: {
+				Computed:    true,
+				Description: "The IDs of the imported images, by catalog image ID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeMap,
+			},
+			Attr_NetworkIDs: {
+				Computed:    true,
+				Description: "The IDs of the networks, by network name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeMap,
+			},
+			Attr_PendingSteps: {
+				Computed:    true,
+				Description: "The bootstrap steps that are not completed.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_SSHKeyIDs: {
+				Computed:    true,
+				Description: "The IDs of the SSH keys, by SSH key name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeMap,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the bootstrap, either completed or incomplete.",
+				Type:        schema.TypeString,
+			},
+			Attr_TransitGatewayConnectionID: {
+				Computed:    true,
+				Description: "The ID of the transit gateway connection.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIWorkspaceBootstrapCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(Arg_Name).(string)
+	datacenter := d.Get(Arg_Datacenter).(string)
+	resourceGroup := d.Get(Arg_ResourceGroupID).(string)
+	plan := d.Get(Arg_Plan).(string)
+
+	// No need for cloudInstanceID because we are creating a workspace
+	client := instance.NewIBMPIWorkspacesClient(ctx, sess, "")
+	controller, _, err := client.CreateV2(name, datacenter, resourceGroup, plan, map[string]any{})
+	if err != nil {
+		log.Printf("[DEBUG] create workspace failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := *controller.GUID
+	d.SetId(cloudInstanceID)
+	d.Set(Attr_CRN, controller.CRN)
+
+	_, err = waitForResourceWorkspaceCreate(ctx, client, cloudInstanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tags, ok := d.GetOk(Arg_UserTags); ok {
+		if len(flex.FlattenSet(tags.(*schema.Set))) > 0 {
+			oldList, newList := d.GetChange(Arg_UserTags)
+			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *controller.CRN, "", UserTagType)
+			if err != nil {
+				log.Printf("Error on creation of workspace (%s) pi_user_tags: %s", *controller.CRN, err)
+			}
+		}
+	}
+
+	err = bootstrapIBMPIWorkspace(ctx, d, meta)
+	if stepErr, ok := err.(*bootstrapStepError); ok {
+		return flex.PartialCreateDiagnostics(bootstrapStepFailedDiagnostic(stepErr), func() diag.Diagnostics {
+			return resourceIBMPIWorkspaceBootstrapRead(ctx, d, meta)
+		})
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIWorkspaceBootstrapRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceBootstrapRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Id()
+	wsClient := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	controller, response, err := wsClient.GetRC(cloudInstanceID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if controller == nil || *controller.State == State_Removed || *controller.State == State_PendingReclamation {
+		d.SetId("")
+		return nil
+	}
+	d.Set(Arg_Name, controller.Name)
+	d.Set(Attr_CRN, controller.CRN)
+	tags, err := flex.GetGlobalTagsUsingCRN(meta, *controller.CRN, "", UserTagType)
+	if err != nil {
+		log.Printf("Error on get of workspace (%s) pi_user_tags: %s", cloudInstanceID, err)
+	}
+	d.Set(Arg_UserTags, tags)
+
+	zoneSess, err := piSessionForZone(sess, d.Get(Arg_Datacenter).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Children removed outside of Terraform or found in a failed state are
+	// dropped from the state, so that the next apply creates them again.
+	keyClient := instance.NewIBMPISSHKeyClient(ctx, zoneSess, cloudInstanceID)
+	d.Set(Attr_SSHKeyIDs, refreshBootstrapChildren(d.Get(Attr_SSHKeyIDs).(map[string]any), func(id string) error {
+		_, err := keyClient.Get(id)
+		return err
+	}))
+	networkClient := instance.NewIBMPINetworkClient(ctx, zoneSess, cloudInstanceID)
+	d.Set(Attr_NetworkIDs, refreshBootstrapChildren(d.Get(Attr_NetworkIDs).(map[string]any), func(id string) error {
+		_, err := networkClient.Get(id)
+		return err
+	}))
+	imageClient := instance.NewIBMPIImageClient(ctx, zoneSess, cloudInstanceID)
+	d.Set(Attr_ImageIDs, refreshBootstrapChildren(d.Get(Attr_ImageIDs).(map[string]any), func(id string) error {
+		image, err := imageClient.Get(id)
+		if err == nil && isBootstrapChildFailed(image.State) {
+			return fmt.Errorf("%w: image state %s", errBootstrapChildFailed, image.State)
+		}
+		return err
+	}))
+	if id := d.Get(Attr_CloudConnectionID).(string); id != "" {
+		ccClient := instance.NewIBMPICloudConnectionClient(ctx, zoneSess, cloudInstanceID)
+		if _, err := ccClient.Get(id); err != nil && isBootstrapChildNotFound(err) {
+			d.Set(Attr_CloudConnectionID, "")
+		}
+	}
+	if id := d.Get(Attr_DhcpID).(string); id != "" {
+		dhcpClient := instance.NewIBMPIDhcpClient(ctx, zoneSess, cloudInstanceID)
+		dhcpServer, err := dhcpClient.Get(id)
+		if (err != nil && isBootstrapChildNotFound(err)) || (err == nil && dhcpServer.Status != nil && isBootstrapChildFailed(*dhcpServer.Status)) {
+			d.Set(Attr_DhcpID, "")
+		}
+	}
+	if id := d.Get(Attr_TransitGatewayConnectionID).(string); id != "" {
+		tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+		getOptions.SetTransitGatewayID(d.Get(Arg_TransitGatewayID).(string))
+		getOptions.SetID(id)
+		connection, response, err := tgClient.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+		if (err != nil && response != nil && response.StatusCode == 404) || (err == nil && connection.Status != nil && isBootstrapChildFailed(*connection.Status)) {
+			d.Set(Attr_TransitGatewayConnectionID, "")
+		}
+	}
+
+	setIBMPIWorkspaceBootstrapStatus(d)
+	return nil
+}
+
+func resourceIBMPIWorkspaceBootstrapUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChange(Arg_UserTags) {
+		if crn, ok := d.GetOk(Attr_CRN); ok {
+			oldList, newList := d.GetChange(Arg_UserTags)
+			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			if err != nil {
+				log.Printf("Error on update of workspace (%s) pi_user_tags: %s", crn, err)
+			}
+		}
+	}
+
+	// The other arguments force a new resource, an update resumes the pending steps
+	err := bootstrapIBMPIWorkspace(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMPIWorkspaceBootstrapRead(ctx, d, meta)
+}
+
+func resourceIBMPIWorkspaceBootstrapDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Id()
+	timeout := d.Timeout(schema.TimeoutDelete)
+	zoneSess, err := piSessionForZone(sess, d.Get(Arg_Datacenter).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Tear down in the reverse order of the bootstrap. Each child is removed
+	// from the state once deleted, so that a failed delete can be retried.
+	if id := d.Get(Attr_TransitGatewayConnectionID).(string); id != "" {
+		log.Printf("[DEBUG] deleting transit gateway connection %s", id)
+		err := deleteBootstrapTransitGatewayConnection(ctx, meta, d.Get(Arg_TransitGatewayID).(string), id, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(Attr_TransitGatewayConnectionID, "")
+	}
+	if id := d.Get(Attr_DhcpID).(string); id != "" {
+		log.Printf("[DEBUG] deleting DHCP server %s", id)
+		client := instance.NewIBMPIDhcpClient(ctx, zoneSess, cloudInstanceID)
+		err := client.Delete(id)
+		if err != nil && !isBootstrapChildNotFound(err) {
+			return diag.FromErr(err)
+		}
+		_, err = waitForIBMPIDhcpDeleted(ctx, client, id, timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set(Attr_DhcpID, "")
+	}
+	if id := d.Get(Attr_CloudConnectionID).(string); id != "" {
+		log.Printf("[DEBUG] deleting cloud connection %s", id)
+		client := instance.NewIBMPICloudConnectionClient(ctx, zoneSess, cloudInstanceID)
+		deleteJob, err := client.Delete(id)
+		if err != nil && !isBootstrapChildNotFound(err) {
+			return diag.FromErr(err)
+		}
+		if deleteJob != nil {
+			jobClient := instance.NewIBMPIJobClient(ctx, zoneSess, cloudInstanceID)
+			_, err = waitForIBMPIJobCompleted(ctx, jobClient, *deleteJob.ID, timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.Set(Attr_CloudConnectionID, "")
+	}
+
+	networkClient := instance.NewIBMPINetworkClient(ctx, zoneSess, cloudInstanceID)
+	networkIDs := d.Get(Attr_NetworkIDs).(map[string]any)
+	for name, id := range networkIDs {
+		log.Printf("[DEBUG] deleting network %s", name)
+		err := deleteNetworkWithRetry(ctx, networkClient, id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPINetworkDeleted(ctx, networkClient, id.(string), timeout)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		delete(networkIDs, name)
+		d.Set(Attr_NetworkIDs, networkIDs)
+	}
+
+	imageClient := instance.NewIBMPIImageClient(ctx, zoneSess, cloudInstanceID)
+	imageIDs := d.Get(Attr_ImageIDs).(map[string]any)
+	for catalogImageID, id := range imageIDs {
+		log.Printf("[DEBUG] deleting image %s", id)
+		err := imageClient.Delete(id.(string))
+		if err != nil && !isBootstrapChildNotFound(err) {
+			return diag.FromErr(err)
+		}
+		delete(imageIDs, catalogImageID)
+		d.Set(Attr_ImageIDs, imageIDs)
+	}
+
+	keyClient := instance.NewIBMPISSHKeyClient(ctx, zoneSess, cloudInstanceID)
+	sshKeyIDs := d.Get(Attr_SSHKeyIDs).(map[string]any)
+	for name, id := range sshKeyIDs {
+		log.Printf("[DEBUG] deleting SSH key %s", name)
+		err := keyClient.Delete(id.(string))
+		if err != nil && !isBootstrapChildNotFound(err) {
+			return diag.FromErr(err)
+		}
+		delete(sshKeyIDs, name)
+		d.Set(Attr_SSHKeyIDs, sshKeyIDs)
+	}
+
+	wsClient := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+	response, err := wsClient.Delete(cloudInstanceID)
+	if err != nil && response != nil && response.StatusCode == 410 {
+		d.SetId("")
+		return nil
+	}
+	_, err = waitForResourceWorkspaceDelete(ctx, wsClient, cloudInstanceID, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIWorkspaceBootstrapCustomizeDiff plans an update when steps are
+// pending, so that the next apply resumes the bootstrap.
+func resourceIBMPIWorkspaceBootstrapCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || diff.Get(Attr_Status).(string) == State_Completed {
+		return nil
+	}
+	for _, attr := range []string{Attr_CloudConnectionID, Attr_DhcpID, Attr_ImageIDs, Attr_NetworkIDs, Attr_PendingSteps, Attr_SSHKeyIDs, Attr_Status, Attr_TransitGatewayConnectionID} {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bootstrapIBMPIWorkspace runs the pending steps of the bootstrap. The ID of
+// each child is saved once it is ready, and the steps left when an error
+// occurs are reported in pending_steps.
+func bootstrapIBMPIWorkspace(ctx context.Context, d *schema.ResourceData, meta any) error {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	zoneSess, err := piSessionForZone(sess, d.Get(Arg_Datacenter).(string))
+	if err != nil {
+		return err
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	steps := []struct {
+		name string
+		run  func(context.Context, *schema.ResourceData, any, *ibmpisession.IBMPISession, time.Duration) error
+	}{
+		{bootstrapStepSSHKeys, bootstrapIBMPIWorkspaceSSHKeys},
+		{bootstrapStepNetworks, bootstrapIBMPIWorkspaceNetworks},
+		{bootstrapStepCloudConnection, bootstrapIBMPIWorkspaceCloudConnection},
+		{bootstrapStepDhcp, bootstrapIBMPIWorkspaceDhcp},
+		{bootstrapStepTransitGatewayConnection, bootstrapIBMPIWorkspaceTransitGatewayConnection},
+		{bootstrapStepImages, bootstrapIBMPIWorkspaceImages},
+	}
+	for _, step := range steps {
+		if !slices.Contains(pendingIBMPIWorkspaceBootstrapSteps(d), step.name) {
+			continue
+		}
+		log.Printf("[DEBUG] running bootstrap step %s of workspace %s", step.name, d.Id())
+		err := step.run(ctx, d, meta, zoneSess, timeout)
+		setIBMPIWorkspaceBootstrapStatus(d)
+		if err != nil {
+			return &bootstrapStepError{step: step.name, workspace: d.Id(), err: err}
+		}
+	}
+	return nil
+}
+
+// bootstrapStepError is returned by bootstrapIBMPIWorkspace when a step fails.
+type bootstrapStepError struct {
+	step      string
+	workspace string
+	err       error
+}
+
+func (e *bootstrapStepError) Error() string {
+	return fmt.Sprintf("[ERROR] bootstrap step %s of workspace %s failed, the pending steps are resumed on the next apply: %s", e.step, e.workspace, e.err)
+}
+
+func (e *bootstrapStepError) Unwrap() error {
+	return e.err
+}
+
+func bootstrapStepFailedDiagnostic(e *bootstrapStepError) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Bootstrap step %s of workspace %s failed", e.step, e.workspace),
+		Detail:   fmt.Sprintf("%s. The workspace and the children created so far are kept in state and the next apply resumes the pending steps.", e.err),
+	}
+}
+
+func bootstrapIBMPIWorkspaceSSHKeys(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	client := instance.NewIBMPISSHKeyClient(ctx, sess, d.Id())
+	sshKeyIDs := d.Get(Attr_SSHKeyIDs).(map[string]any)
+	for _, v := range d.Get(Arg_SSHKeys).([]any) {
+		key := v.(map[string]any)
+		name := key[Attr_Name].(string)
+		if _, ok := sshKeyIDs[name]; ok {
+			continue
+		}
+		sshKey := key[Attr_SSHKey].(string)
+		visibility := Workspace
+		sshKeyResponse, err := client.Create(&models.CreateWorkspaceSSHKey{
+			Name:       &name,
+			SSHKey:     &sshKey,
+			Visibility: &visibility,
+		})
+		if err != nil {
+			return err
+		}
+		sshKeyIDs[name] = *sshKeyResponse.ID
+		d.Set(Attr_SSHKeyIDs, sshKeyIDs)
+	}
+	return nil
+}
+
+func bootstrapIBMPIWorkspaceNetworks(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	cloudInstanceID := d.Id()
+	if !sess.IsOnPrem() {
+		wsClient := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
+		wsData, err := wsClient.Get(cloudInstanceID)
+		if err != nil {
+			return err
+		}
+		if wsData.Capabilities[PER] {
+			_, err = waitForPERWorkspaceActive(ctx, wsClient, cloudInstanceID, timeout)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	networkIDs := d.Get(Attr_NetworkIDs).(map[string]any)
+	for _, v := range d.Get(Arg_Networks).([]any) {
+		network := v.(map[string]any)
+		name := network[Attr_Name].(string)
+		if _, ok := networkIDs[name]; ok {
+			continue
+		}
+		networkType := network[Attr_Type].(string)
+		body := &models.NetworkCreate{
+			Name:       name,
+			Type:       &networkType,
+			DNSServers: flex.ExpandStringList(network[Attr_DNS].([]any)),
+		}
+		cidr := network[Attr_CIDR].(string)
+		if networkType == Vlan {
+			if cidr == "" {
+				return fmt.Errorf("%s is required for the %s network %s", Attr_CIDR, Vlan, name)
+			}
+			gateway, firstIP, lastIP, err := generateIPData(cidr)
+			if err != nil {
+				return err
+			}
+			body.Cidr = cidr
+			body.Gateway = gateway
+			body.IPAddressRanges = []*models.IPAddressRange{{EndingIPAddress: &lastIP, StartingIPAddress: &firstIP}}
+		} else if cidr != "" {
+			return fmt.Errorf("%s cannot be set for the %s network %s", Attr_CIDR, PubVlan, name)
+		}
+
+		networkResponse, err := createNetworkWithRetry(ctx, client, body)
+		if err != nil {
+			return err
+		}
+		networkID := *networkResponse.NetworkID
+		_, err = isWaitForIBMPINetworkAvailable(ctx, client, networkID, timeout)
+		if err != nil {
+			return discardBootstrapChild("network", networkID, err, func() error {
+				return client.Delete(networkID)
+			})
+		}
+		networkIDs[name] = networkID
+		d.Set(Attr_NetworkIDs, networkIDs)
+	}
+	return nil
+}
+
+func bootstrapIBMPIWorkspaceCloudConnection(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	cloudInstanceID := d.Id()
+	cc := d.Get(Arg_CloudConnection + ".0").(map[string]any)
+	name := cc[Attr_Name].(string)
+	speed := int64(cc[Attr_Speed].(int))
+	body := &models.CloudConnectionCreate{
+		GlobalRouting:  cc[Attr_GlobalRouting].(bool),
+		Metered:        cc[Attr_Metered].(bool),
+		Name:           &name,
+		Speed:          &speed,
+		TransitEnabled: cc[Attr_TransitEnabled].(bool),
+	}
+	// Only the private networks can be attached to a cloud connection
+	networkIDs := d.Get(Attr_NetworkIDs).(map[string]any)
+	for _, v := range d.Get(Arg_Networks).([]any) {
+		network := v.(map[string]any)
+		if network[Attr_Type].(string) == Vlan {
+			if id, ok := networkIDs[network[Attr_Name].(string)]; ok {
+				body.Subnets = append(body.Subnets, id.(string))
+			}
+		}
+	}
+
+	client := instance.NewIBMPICloudConnectionClient(ctx, sess, cloudInstanceID)
+	cloudConnection, cloudConnectionJob, err := client.Create(body)
+	if err != nil {
+		return err
+	}
+	if cloudConnection != nil {
+		d.Set(Attr_CloudConnectionID, cloudConnection.CloudConnectionID)
+	} else if cloudConnectionJob != nil {
+		cloudConnectionID := *cloudConnectionJob.CloudConnectionID
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *cloudConnectionJob.JobRef.ID, timeout)
+		if err != nil {
+			return discardBootstrapChild("cloud connection", cloudConnectionID, err, func() error {
+				_, err := client.Delete(cloudConnectionID)
+				return err
+			})
+		}
+		d.Set(Attr_CloudConnectionID, cloudConnectionID)
+	}
+	return nil
+}
+
+func bootstrapIBMPIWorkspaceDhcp(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	dhcp := d.Get(Arg_Dhcp + ".0").(map[string]any)
+	snatEnabled := dhcp[Attr_SnatEnabled].(bool)
+	body := &models.DHCPServerCreate{
+		SnatEnabled: &snatEnabled,
+	}
+	if v := dhcp[Attr_CIDR].(string); v != "" {
+		body.Cidr = &v
+	}
+	if v := dhcp[Attr_DNSServer].(string); v != "" {
+		body.DNSServer = &v
+	}
+	if v := dhcp[Attr_Name].(string); v != "" {
+		body.Name = &v
+	}
+	if v := d.Get(Attr_CloudConnectionID).(string); v != "" {
+		body.CloudConnectionID = &v
+	}
+
+	client := instance.NewIBMPIDhcpClient(ctx, sess, d.Id())
+	dhcpServer, err := client.Create(body)
+	if err != nil {
+		return err
+	}
+	dhcpID := *dhcpServer.ID
+	_, err = waitForIBMPIDhcpStatus(ctx, client, dhcpID, timeout)
+	if err != nil {
+		return discardBootstrapChild("DHCP server", dhcpID, err, func() error {
+			return client.Delete(dhcpID)
+		})
+	}
+	d.Set(Attr_DhcpID, dhcpID)
+	return nil
+}
+
+func bootstrapIBMPIWorkspaceTransitGatewayConnection(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	client, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return err
+	}
+
+	gatewayID := d.Get(Arg_TransitGatewayID).(string)
+	options := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
+	options.SetTransitGatewayID(gatewayID)
+	options.SetName(d.Get(Arg_Name).(string))
+	options.SetNetworkType("power_virtual_server")
+	options.SetNetworkID(d.Get(Attr_CRN).(string))
+	connection, response, err := client.CreateTransitGatewayConnectionWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("%w\n%s", err, response)
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Pending},
+		Target:  []string{State_Attached},
+		Refresh: func() (any, string, error) {
+			getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getOptions.SetTransitGatewayID(gatewayID)
+			getOptions.SetID(*connection.ID)
+			connection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("%w\n%s", err, response)
+			}
+			if connection.Status == nil {
+				return connection, State_Pending, nil
+			}
+			if *connection.Status == State_Failed {
+				return connection, *connection.Status, fmt.Errorf("[ERROR] transit gateway connection %s is in failed state", *connection.ID)
+			}
+			return connection, *connection.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return discardBootstrapChild("transit gateway connection", *connection.ID, err, func() error {
+			return deleteBootstrapTransitGatewayConnection(ctx, meta, gatewayID, *connection.ID, timeout)
+		})
+	}
+	d.Set(Attr_TransitGatewayConnectionID, connection.ID)
+	return nil
+}
+
+func bootstrapIBMPIWorkspaceImages(ctx context.Context, d *schema.ResourceData, meta any, sess *ibmpisession.IBMPISession, timeout time.Duration) error {
+	client := instance.NewIBMPIImageClient(ctx, sess, d.Id())
+	imageIDs := d.Get(Attr_ImageIDs).(map[string]any)
+	for _, v := range d.Get(Arg_CatalogImageIDs).(*schema.Set).List() {
+		catalogImageID := v.(string)
+		if _, ok := imageIDs[catalogImageID]; ok {
+			continue
+		}
+		source := "root-project"
+		imageResponse, err := client.Create(&models.CreateImage{
+			ImageID: catalogImageID,
+			Source:  &source,
+		})
+		if err != nil {
+			return err
+		}
+		imageID := *imageResponse.ImageID
+		_, err = isWaitForIBMPIImageAvailable(ctx, client, imageID, timeout)
+		if err != nil {
+			return discardBootstrapChild("image", imageID, err, func() error {
+				return client.Delete(imageID)
+			})
+		}
+		imageIDs[catalogImageID] = imageID
+		d.Set(Attr_ImageIDs, imageIDs)
+	}
+	return nil
+}
+
+// discardBootstrapChild deletes a child that did not become ready and returns
+// the error of its wait. The ID of the child is not saved, so the step
+// creates it again on the next apply.
+func discardBootstrapChild(kind, id string, err error, deleteChild func() error) error {
+	log.Printf("[DEBUG] deleting %s %s that did not become ready", kind, id)
+	if deleteErr := deleteChild(); deleteErr != nil && !isBootstrapChildNotFound(deleteErr) {
+		return fmt.Errorf("%w\nthe %s %s could not be deleted and must be removed manually: %s", err, kind, id, deleteErr)
+	}
+	return err
+}
+
+func deleteBootstrapTransitGatewayConnection(ctx context.Context, meta any, gatewayID, id string, timeout time.Duration) error {
+	client, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return err
+	}
+
+	deleteOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionOptions{}
+	deleteOptions.SetTransitGatewayID(gatewayID)
+	deleteOptions.SetID(id)
+	response, err := client.DeleteTransitGatewayConnectionWithContext(ctx, deleteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("%w\n%s", err, response)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Deleting},
+		Target:  []string{State_Deleted},
+		Refresh: func() (any, string, error) {
+			getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getOptions.SetTransitGatewayID(gatewayID)
+			getOptions.SetID(id)
+			connection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return connection, State_Deleted, nil
+				}
+				return nil, "", fmt.Errorf("%w\n%s", err, response)
+			}
+			return connection, State_Deleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// pendingIBMPIWorkspaceBootstrapSteps returns the steps whose children are
+// configured but not recorded in the state.
+func pendingIBMPIWorkspaceBootstrapSteps(d *schema.ResourceData) []string {
+	pending := []string{}
+	missing := func(list []any, key string, ids map[string]any) bool {
+		for _, v := range list {
+			var name string
+			if m, ok := v.(map[string]any); ok {
+				name = m[key].(string)
+			} else {
+				name = v.(string)
+			}
+			if _, ok := ids[name]; !ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if missing(d.Get(Arg_SSHKeys).([]any), Attr_Name, d.Get(Attr_SSHKeyIDs).(map[string]any)) {
+		pending = append(pending, bootstrapStepSSHKeys)
+	}
+	if missing(d.Get(Arg_Networks).([]any), Attr_Name, d.Get(Attr_NetworkIDs).(map[string]any)) {
+		pending = append(pending, bootstrapStepNetworks)
+	}
+	if len(d.Get(Arg_CloudConnection).([]any)) > 0 && d.Get(Attr_CloudConnectionID).(string) == "" {
+		pending = append(pending, bootstrapStepCloudConnection)
+	}
+	if len(d.Get(Arg_Dhcp).([]any)) > 0 && d.Get(Attr_DhcpID).(string) == "" {
+		pending = append(pending, bootstrapStepDhcp)
+	}
+	if d.Get(Arg_TransitGatewayID).(string) != "" && d.Get(Attr_TransitGatewayConnectionID).(string) == "" {
+		pending = append(pending, bootstrapStepTransitGatewayConnection)
+	}
+	if missing(d.Get(Arg_CatalogImageIDs).(*schema.Set).List(), "", d.Get(Attr_ImageIDs).(map[string]any)) {
+		pending = append(pending, bootstrapStepImages)
+	}
+	return pending
+}
+
+func setIBMPIWorkspaceBootstrapStatus(d *schema.ResourceData) {
+	pending := pendingIBMPIWorkspaceBootstrapSteps(d)
+	d.Set(Attr_PendingSteps, pending)
+	if len(pending) == 0 {
+		d.Set(Attr_Status, State_Completed)
+	} else {
+		d.Set(Attr_Status, State_Incomplete)
+	}
+}
+
+// refreshBootstrapChildren returns the children of ids that still exist and
+// are not failed. get returns errBootstrapChildFailed for a failed child.
+func refreshBootstrapChildren(ids map[string]any, get func(id string) error) map[string]any {
+	for name, id := range ids {
+		if err := get(id.(string)); err != nil && (isBootstrapChildNotFound(err) || errors.Is(err, errBootstrapChildFailed)) {
+			log.Printf("[DEBUG] bootstrap child %s (%s) is dropped: %s", name, id, err)
+			delete(ids, name)
+		}
+	}
+	return ids
+}
+
+// errBootstrapChildFailed is returned by the get function of
+// refreshBootstrapChildren for a child in a failed state.
+var errBootstrapChildFailed = errors.New("bootstrap child is in a failed state")
+
+func isBootstrapChildFailed(state string) bool {
+	state = strings.ToLower(state)
+	return state == State_Error || state == State_Failed
+}
+
+func isBootstrapChildNotFound(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), NotFound)
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIWorkspaceBootstrapBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-workspace-bootstrap-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	bootstrapRes := "ibm_pi_workspace_bootstrap.bootstrap"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccIBMPIWorkspaceBootstrapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIWorkspaceBootstrapConfig(name, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists(bootstrapRes),
+					resource.TestCheckResourceAttrSet(bootstrapRes, "crn"),
+					resource.TestCheckResourceAttr(bootstrapRes, "status", power.State_Completed),
+					resource.TestCheckResourceAttr(bootstrapRes, "pending_steps.#", "0"),
+					resource.TestCheckResourceAttrSet(bootstrapRes, "network_ids.private"),
+					resource.TestCheckResourceAttrSet(bootstrapRes, "network_ids.public"),
+					resource.TestCheckResourceAttrSet(bootstrapRes, "ssh_key_ids.key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIWorkspaceBootstrapConfig(name, publicKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_workspace_bootstrap" "bootstrap" {
+			pi_name              = "%[1]s"
+			pi_datacenter        = "dal12"
+			pi_resource_group_id = "%[2]s"
+
+			pi_ssh_keys {
+				name    = "key"
+				ssh_key = "%[3]s"
+			}
+			pi_networks {
+				name = "private"
+				type = "vlan"
+				cidr = "192.168.10.0/24"
+				dns  = ["127.0.0.1"]
+			}
+			pi_networks {
+				name = "public"
+				type = "pub-vlan"
+			}
+		}`, name, acc.Pi_resource_group_id, publicKey)
+}
+
+func testAccIBMPIWorkspaceBootstrapDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_workspace_bootstrap" {
+			continue
+		}
+		cloudInstanceID := rs.Primary.ID
+		client := instance.NewIBMPIWorkspacesClient(context.Background(), sess, cloudInstanceID)
+		workspace, resp, err := client.GetRC(cloudInstanceID)
+		if err == nil {
+			if *workspace.State == power.State_Active {
+				return fmt.Errorf("Resource Instance still exists: %s", rs.Primary.ID)
+			}
+		} else {
+			if !strings.Contains(err.Error(), "404") {
+				return fmt.Errorf("[ERROR] Error checking if Resource Instance (%s) has been destroyed: %s with resp code: %s", rs.Primary.ID, err, resp)
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -68,6 +69,22 @@ func DataSourceIBMSatelliteAttachHostScript() *schema.Resource {
 				Computed:    true,
 				Description: "Attach host script content",
 			},
+			"write_script": {
+				Description: "If false, the attach host script is not written to script_dir. The content is still available in host_script and user_data",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attach host script rendered as cloud-init user data for RHEL hosts, or the ignition config for CoreOS hosts",
+			},
+			"user_data_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded user_data, such as for VMware guest customization",
+			},
 			"custom_script": {
 				Description:  "The custom script that has to be appended to generated host script file",
 				Type:         schema.TypeString,
@@ -87,6 +104,7 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	var scriptDir string
 	location := d.Get("location").(string)
 	hostProvider := d.Get("host_provider").(string)
+	coreosEnabled := d.Get("coreos_host").(bool)
 
 	if _, ok := d.GetOk("script_dir"); ok {
 		scriptDir = d.Get("script_dir").(string)
@@ -97,8 +115,65 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		return err
 	}
 
+	// script labels
+	labels := make(map[string]string)
+	if v, ok := d.GetOk("labels"); ok {
+		l := v.(*schema.Set)
+		labels, err = flex.ParseKeyValues(flex.ExpandStringList(l.List()))
+		if err != nil {
+			return err
+		}
+		d.Set("labels", l)
+	}
+
+	locData, scriptContent, err := generateSatelliteAttachHostScript(satClient, location, labels, coreosEnabled, hostProvider, d.Get("custom_script").(string), d.Get("host_link_agent_endpoint").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.Get("write_script").(bool) {
+		if len(scriptDir) == 0 {
+			scriptDir, err = homedir.Dir()
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error fetching homedir: %s", err)
+			}
+		}
+		scriptDir, _ = filepath.Abs(scriptDir)
+		scriptPath := filepath.Join(scriptDir, "addHost.sh")
+		if coreosEnabled {
+			scriptPath = filepath.Join(scriptDir, "addHost.ign")
+		}
+
+		err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
+		}
+		d.Set("script_dir", scriptDir)
+		d.Set("script_path", scriptPath)
+	} else {
+		d.Set("script_path", "")
+	}
+
+	userData := renderSatelliteAttachHostUserData(scriptContent, coreosEnabled)
+	d.Set("location", location)
+	d.Set("host_script", scriptContent)
+	d.Set("user_data", userData)
+	d.Set("user_data_base64", base64.StdEncoding.EncodeToString([]byte(userData)))
+	d.Set("host_provider", hostProvider)
+	d.SetId(*locData.ID)
+
+	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
+
+	return nil
+}
+
+// generateSatelliteAttachHostScript generates the attach host script of a
+// location. RHEL scripts get the provider specific setup, or the custom
+// script, inserted before the host is registered.
+func generateSatelliteAttachHostScript(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string, labels map[string]string, coreosEnabled bool, hostProvider, customScript, hostLinkAgentEndpoint string) (*kubernetesserviceapiv1.MultishiftGetController, string, error) {
 	var locData *kubernetesserviceapiv1.MultishiftGetController
 	var response *core.DetailedResponse
+	var err error
 	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
 		Controller: &location,
 	}
@@ -118,25 +193,8 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		locData, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
 	}
 	if err != nil || locData == nil {
-		return fmt.Errorf("[ERROR] Error getting Satellite location (%s): %s\n%s", location, err, response)
-	}
-
-	// script labels
-	labels := make(map[string]string)
-	if v, ok := d.GetOk("labels"); ok {
-		l := v.(*schema.Set)
-		labels = flex.FlattenKeyValues(l.List())
-		d.Set("labels", l)
-	}
-
-	if len(scriptDir) == 0 {
-		scriptDir, err = homedir.Dir()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching homedir: %s", err)
-		}
+		return nil, "", fmt.Errorf("[ERROR] Error getting Satellite location (%s): %s\n%s", location, err, response)
 	}
-	scriptDir, _ = filepath.Abs(scriptDir)
-	var scriptPath string
 
 	//Generate script
 	createRegOptions := &kubernetesserviceapiv1.AttachSatelliteHostOptions{}
@@ -144,37 +202,26 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 	createRegOptions.Labels = labels
 
 	//check to see if host attach is CoreOS or RHEL
-	var host_os string
-	var coreos_enabled bool
-	if _, ok := d.GetOk("coreos_host"); ok {
-		coreos_enabled = d.Get("coreos_host").(bool)
-		if coreos_enabled {
-			host_os = "RHCOS"
-			createRegOptions.OperatingSystem = &host_os
-			scriptPath = filepath.Join(scriptDir, "addHost.ign")
-		}
-	} else {
-		coreos_enabled = false
-		host_os = "RHEL"
-		createRegOptions.OperatingSystem = &host_os
-		scriptPath = filepath.Join(scriptDir, "addHost.sh")
+	host_os := "RHEL"
+	if coreosEnabled {
+		host_os = "RHCOS"
 	}
+	createRegOptions.OperatingSystem = &host_os
 
 	// If the user supplied link agent endpoint, use reduced firewall attach script
-	if hlae, ok := d.GetOk("host_link_agent_endpoint"); ok {
-		host_link_agent_endpoint := hlae.(string)
-		createRegOptions.HostLinkAgentEndpoint = &host_link_agent_endpoint
+	if hostLinkAgentEndpoint != "" {
+		createRegOptions.HostLinkAgentEndpoint = &hostLinkAgentEndpoint
 	}
 
 	resp, err := satClient.AttachSatelliteHost(createRegOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Generating Satellite Registration Script: %s\n%s", err, resp)
+		return nil, "", fmt.Errorf("[ERROR] Error Generating Satellite Registration Script: %s\n%s", err, resp)
 	}
 
 	scriptContent := string(resp)
 
 	//if this is a RHEL host, find insert point for custom code
-	if !coreos_enabled {
+	if !coreosEnabled {
 		lines := strings.Split(scriptContent, "\n")
 		var index int
 		for i, line := range lines {
@@ -185,7 +232,6 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		}
 
 		var insertionText string
-
 		switch {
 		case strings.ToLower(hostProvider) == "aws":
 			insertionText = `
@@ -226,28 +272,31 @@ yum install container-selinux -y
 yum install container-selinux -y
 `
 		default:
-			if script, ok := d.GetOk("custom_script"); ok {
-				insertionText = script.(string)
-			}
+			insertionText = customScript
 		}
 
 		lines[index] = lines[index] + "\n" + insertionText
 		scriptContent = strings.Join(lines, "\n")
 	}
 
-	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
-	}
-
-	d.Set("location", location)
-	d.Set("host_script", scriptContent)
-	d.Set("host_provider", hostProvider)
-	d.Set("script_dir", scriptDir)
-	d.Set("script_path", scriptPath)
-	d.SetId(*locData.ID)
-
-	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
+	return locData, scriptContent, nil
+}
 
-	return nil
+// renderSatelliteAttachHostUserData renders the attach host script so that
+// it can be passed as user data when the host is provisioned. CoreOS scripts
+// are already ignition configs. RHEL scripts are wrapped in a cloud-init
+// config that writes the script on the host and runs it.
+func renderSatelliteAttachHostUserData(scriptContent string, coreosEnabled bool) string {
+	if coreosEnabled {
+		return scriptContent
+	}
+	return fmt.Sprintf(`#cloud-config
+write_files:
+  - path: /usr/local/bin/ibm-satellite-attach-host.sh
+    permissions: "0700"
+    encoding: b64
+    content: %s
+runcmd:
+  - [bash, /usr/local/bin/ibm-satellite-attach-host.sh]
+`, base64.StdEncoding.EncodeToString([]byte(scriptContent)))
 }
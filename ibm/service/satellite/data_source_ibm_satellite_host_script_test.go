@@ -5,6 +5,7 @@ package satellite_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -79,3 +80,39 @@ data "ibm_satellite_attach_host_script" "script" {
 	host_link_agent_endpoint = "testendpoint"
 }`, locationName)
 }
+
+// test script generation without writing the script file
+func TestAccIBMSatelliteAttachHostScriptDataSourceNoWrite(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-nowrite-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigNoWrite(locationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_satellite_attach_host_script.script", "script_path", ""),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_attach_host_script.script", "host_script"),
+					resource.TestMatchResourceAttr("data.ibm_satellite_attach_host_script.script", "user_data", regexp.MustCompile("^#cloud-config")),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_attach_host_script.script", "user_data_base64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigNoWrite(locationName string) string {
+	return fmt.Sprintf(`
+resource "ibm_satellite_location" "testacc_satellite" {
+	location     = "%s"
+	managed_from = "wdc04"
+	zones		 = ["us-east-1", "us-east-2", "us-east-3"]
+}
+
+data "ibm_satellite_attach_host_script" "script" {
+	location       = ibm_satellite_location.testacc_satellite.id
+	labels         = ["env:prod"]
+	host_provider  = "ibm"
+	write_script   = false
+}`, locationName)
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &satelliteAttachHostScriptEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &satelliteAttachHostScriptEphemeralResource{}
+)
+
+func NewSatelliteAttachHostScriptEphemeralResource() ephemeral.EphemeralResource {
+	return &satelliteAttachHostScriptEphemeralResource{}
+}
+
+// satelliteAttachHostScriptEphemeralResource generates the attach host script
+// in memory. Nothing is written to disk or persisted in plan or state.
+type satelliteAttachHostScriptEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type satelliteAttachHostScriptModel struct {
+	Location              types.String `tfsdk:"location"`
+	CoreOSHost            types.Bool   `tfsdk:"coreos_host"`
+	Labels                types.Set    `tfsdk:"labels"`
+	HostProvider          types.String `tfsdk:"host_provider"`
+	CustomScript          types.String `tfsdk:"custom_script"`
+	HostLinkAgentEndpoint types.String `tfsdk:"host_link_agent_endpoint"`
+	HostScript            types.String `tfsdk:"host_script"`
+	UserData              types.String `tfsdk:"user_data"`
+	UserDataBase64        types.String `tfsdk:"user_data_base64"`
+}
+
+func (e *satelliteAttachHostScriptEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_satellite_attach_host_script"
+}
+
+func (e *satelliteAttachHostScriptEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates the attach host script of a Satellite location in memory without writing it to disk or storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				Required:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			"coreos_host": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, returns a CoreOS ignition file for the host. Otherwise, returns a RHEL attach script",
+			},
+			"labels": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of labels for the attach host",
+			},
+			"host_provider": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of host provider, such as ibm, aws, azure or google",
+			},
+			"custom_script": schema.StringAttribute{
+				Optional:    true,
+				Description: "The custom script that has to be appended to generated host script",
+			},
+			"host_link_agent_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "The satellite link agent endpoint, required for reduced firewall attach script",
+			},
+			"host_script": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Attach host script content",
+			},
+			"user_data": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The attach host script rendered as cloud-init user data for RHEL hosts, or the ignition config for CoreOS hosts",
+			},
+			"user_data_base64": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded user_data, such as for VMware guest customization",
+			},
+		},
+	}
+}
+
+func (e *satelliteAttachHostScriptEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.session = session
+}
+
+func (e *satelliteAttachHostScriptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data satelliteAttachHostScriptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostProvider := data.HostProvider.ValueString()
+	customScript := data.CustomScript.ValueString()
+	if (hostProvider == "") == (customScript == "") {
+		resp.Diagnostics.AddError("Invalid Attach Host Script Configuration", "Exactly one of host_provider or custom_script must be set")
+		return
+	}
+
+	var labelList []string
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labelList, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	labels, err := flex.ParseKeyValues(labelList)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Label", err.Error())
+		return
+	}
+
+	satClient, err := e.session.SatelliteClientSession()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Satellite Client", err.Error())
+		return
+	}
+
+	coreosEnabled := data.CoreOSHost.ValueBool()
+	_, scriptContent, err := generateSatelliteAttachHostScript(satClient, data.Location.ValueString(), labels, coreosEnabled, hostProvider, customScript, data.HostLinkAgentEndpoint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Attach Host Script", err.Error())
+		return
+	}
+
+	userData := renderSatelliteAttachHostUserData(scriptContent, coreosEnabled)
+	data.HostScript = types.StringValue(scriptContent)
+	data.UserData = types.StringValue(userData)
+	data.UserDataBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(userData)))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSatelliteAttachHostScriptEphemeralResourceBasic(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-ephemeral-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The provisioner fails unless the ephemeral resource returns
+				// the script and its user data
+				Config: testAccCheckIBMSatelliteAttachHostScriptEphemeralResourceConfig(locationName, `["env:prod"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.script", "id"),
+					testAccCheckIBMSatelliteAttachHostScriptNotInState,
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSatelliteAttachHostScriptEphemeralResourceInvalidLabel(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-ephemeral-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSatelliteAttachHostScriptEphemeralResourceConfig(locationName, `["env"]`),
+				ExpectError: regexp.MustCompile("incorrect format"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteAttachHostScriptNotInState(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "ibm_satellite_attach_host_script" {
+			return fmt.Errorf("[ERROR] ephemeral resource ibm_satellite_attach_host_script was stored in state")
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMSatelliteAttachHostScriptEphemeralResourceConfig(locationName, labels string) string {
+	return fmt.Sprintf(`
+resource "ibm_satellite_location" "testacc_satellite" {
+	location     = "%s"
+	managed_from = "wdc04"
+	zones		 = ["us-east-1", "us-east-2", "us-east-3"]
+}
+
+ephemeral "ibm_satellite_attach_host_script" "script" {
+	location       = ibm_satellite_location.testacc_satellite.id
+	labels         = %s
+	host_provider  = "ibm"
+}
+
+resource "terraform_data" "script" {
+	provisioner "local-exec" {
+		command = "test -n \"$HOST_SCRIPT\" && test -n \"$USER_DATA\""
+		environment = {
+			HOST_SCRIPT = ephemeral.ibm_satellite_attach_host_script.script.host_script
+			USER_DATA   = ephemeral.ibm_satellite_attach_host_script.script.user_data_base64
+		}
+	}
+}`, locationName, labels)
+}
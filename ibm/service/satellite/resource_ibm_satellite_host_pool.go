@@ -0,0 +1,650 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostPoolHostIDs     = "host_ids"
+	hostPoolSelector    = "host_selector"
+	hostPoolTargets     = "targets"
+	hostPoolAssignments = "assignments"
+	hostPoolRemoveHosts = "remove_released_hosts"
+)
+
+func ResourceIBMSatelliteHostPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSatelliteHostPoolCreate,
+		ReadContext:   resourceIBMSatelliteHostPoolRead,
+		UpdateContext: resourceIBMSatelliteHostPoolUpdate,
+		DeleteContext: resourceIBMSatelliteHostPoolDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMSatelliteHostPoolCustomizeDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			hostPoolHostIDs: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs or names of the attached hosts to distribute. If not set, every unassigned host of the location is a candidate",
+			},
+			hostPoolSelector: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels that a host must have to be a candidate",
+			},
+			hostPoolTargets: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The control plane or worker pool targets to assign hosts to, in order of priority",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						hostCluster: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name or ID of the cluster to assign hosts to. If not set, hosts are assigned to the location control plane",
+						},
+						hostWorkerPool: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "default",
+							Description: "The name or ID of the worker pool within the cluster to assign hosts to",
+						},
+						"zones": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The zones to spread the hosts across",
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of hosts to assign to the target, spread evenly across the zones",
+						},
+						hostLabels: {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Labels, such as cpu or memory, that a host must have to be assigned to the target",
+						},
+					},
+				},
+			},
+			hostPoolRemoveHosts: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the hosts that the pool no longer needs, and all its hosts on delete, from the location. Satellite cannot unassign a host, so by default the hosts are released: they stay assigned and are no longer managed by the pool",
+			},
+			hostPoolAssignments: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts that are assigned by this resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						hostID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host ID",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The host name",
+						},
+						"target_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The index of the target in targets that the host is assigned to",
+						},
+						hostCluster: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cluster or location the host is assigned to",
+						},
+						hostWorkerPool: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker pool the host is assigned to",
+						},
+						hostZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the host is assigned to",
+						},
+						hostState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// satelliteHostPoolAssignment is a host assigned by the pool
+type satelliteHostPoolAssignment struct {
+	hostID      string
+	hostName    string
+	targetIndex int
+	cluster     string
+	workerPool  string
+	zone        string
+	state       string
+}
+
+// satelliteHostPoolIncompleteError reports that the pool could not be
+// completed yet, because candidate hosts are missing or assigned hosts did not
+// get normal in time. The next apply resumes the assignment.
+type satelliteHostPoolIncompleteError struct {
+	err error
+}
+
+func (e *satelliteHostPoolIncompleteError) Error() string {
+	return e.err.Error()
+}
+
+func resourceIBMSatelliteHostPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get(hostLocation).(string)
+	d.SetId(fmt.Sprintf("%s/%s", location, resource.PrefixedUniqueId("hostpool-")))
+
+	err := ensureIBMSatelliteHostPool(d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		_, incomplete := err.(*satelliteHostPoolIncompleteError)
+		if !incomplete && len(expandSatelliteHostPoolAssignments(d)) == 0 {
+			d.SetId("")
+			return diag.FromErr(err)
+		}
+		return flex.PartialCreateDiagnostics(satelliteHostPoolIncompleteDiagnostic(location, err), func() diag.Diagnostics {
+			return resourceIBMSatelliteHostPoolRead(ctx, d, meta)
+		})
+	}
+	return resourceIBMSatelliteHostPoolRead(ctx, d, meta)
+}
+
+func satelliteHostPoolIncompleteDiagnostic(location string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Host pool in location %s is incomplete, the next apply resumes the assignment", location),
+		Detail:   err.Error(),
+	}
+}
+
+func resourceIBMSatelliteHostPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get(hostLocation).(string)
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error getting the hosts of location %s: %s\n%s", location, err, resp)
+	}
+
+	assignments := []map[string]interface{}{}
+	for _, a := range expandSatelliteHostPoolAssignments(d) {
+		found := false
+		for _, h := range hostList {
+			if a.hostID != flex.StringValue(h.ID) {
+				continue
+			}
+			found = true
+			if h.Health != nil {
+				a.state = flex.StringValue(h.Health.Status)
+			}
+			if h.Assignment != nil && flex.StringValue(h.Assignment.Zone) != "" {
+				a.zone = flex.StringValue(h.Assignment.Zone)
+			}
+		}
+		if !found {
+			log.Printf("[WARN] Host %s of the host pool was removed from location %s", a.hostID, location)
+			continue
+		}
+		assignments = append(assignments, flattenSatelliteHostPoolAssignment(a))
+	}
+	d.Set(hostPoolAssignments, assignments)
+	return nil
+}
+
+func resourceIBMSatelliteHostPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := ensureIBMSatelliteHostPool(d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		// An incomplete pool is reported the same way as on create
+		if _, incomplete := err.(*satelliteHostPoolIncompleteError); !incomplete {
+			return diag.FromErr(err)
+		}
+		diags := diag.Diagnostics{satelliteHostPoolIncompleteDiagnostic(d.Get(hostLocation).(string), err)}
+		return append(diags, resourceIBMSatelliteHostPoolRead(ctx, d, meta)...)
+	}
+	return resourceIBMSatelliteHostPoolRead(ctx, d, meta)
+}
+
+func resourceIBMSatelliteHostPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get(hostLocation).(string)
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, a := range expandSatelliteHostPoolAssignments(d) {
+		err := releaseSatelliteHostPoolHost(d, satClient, location, a.hostID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMSatelliteHostPoolCustomizeDiff forces an update when a target
+// has fewer assigned hosts than its count, so that a failed or partial
+// assignment is resumed on the next apply.
+func resourceIBMSatelliteHostPoolCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	assigned := map[int]int{}
+	for _, a := range diff.Get(hostPoolAssignments).([]interface{}) {
+		assignment := a.(map[string]interface{})
+		if assignment[hostState].(string) == rsHostNormalStatus {
+			assigned[assignment["target_index"].(int)]++
+		}
+	}
+	for i, t := range diff.Get(hostPoolTargets).([]interface{}) {
+		if t != nil && assigned[i] < t.(map[string]interface{})["count"].(int) {
+			return diff.SetNewComputed(hostPoolAssignments)
+		}
+	}
+	return nil
+}
+
+// ensureIBMSatelliteHostPool releases the hosts that are no longer needed,
+// assigns candidate hosts to the targets that are short of hosts and waits
+// until every assigned host is normal. Assignments are saved in the state as
+// they are made, so a failed run is resumed by the next apply. Missing
+// candidates and wait timeouts are reported as satelliteHostPoolIncompleteError.
+func ensureIBMSatelliteHostPool(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	location := d.Get(hostLocation).(string)
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the hosts of location %s: %s\n%s", location, err, resp)
+	}
+	hosts := map[string]kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, h := range hostList {
+		hosts[flex.StringValue(h.ID)] = h
+	}
+
+	targets := d.Get(hostPoolTargets).([]interface{})
+
+	// Keep the existing assignments that still match a target zone, up to
+	// the count of each zone
+	perZone := map[string]int{}
+	kept := []satelliteHostPoolAssignment{}
+	released := []satelliteHostPoolAssignment{}
+	for _, a := range expandSatelliteHostPoolAssignments(d) {
+		if _, ok := hosts[a.hostID]; !ok {
+			continue
+		}
+		keep := false
+		if a.targetIndex < len(targets) && targets[a.targetIndex] != nil {
+			target := targets[a.targetIndex].(map[string]interface{})
+			zones := flex.ExpandStringList(target["zones"].([]interface{}))
+			key := fmt.Sprintf("%d/%s", a.targetIndex, a.zone)
+			if a.cluster == satelliteHostPoolCluster(target, location) && a.workerPool == target[hostWorkerPool].(string) &&
+				flex.StringContains(zones, a.zone) && perZone[key] < satelliteHostPoolZoneCount(target["count"].(int), zones, a.zone) {
+				keep = true
+				perZone[key]++
+			}
+		}
+		if keep {
+			kept = append(kept, a)
+		} else {
+			released = append(released, a)
+		}
+	}
+	for i, a := range released {
+		err := releaseSatelliteHostPoolHost(d, satClient, location, a.hostID)
+		if err != nil {
+			setSatelliteHostPoolAssignments(d, append(kept, released[i:]...))
+			return err
+		}
+	}
+	setSatelliteHostPoolAssignments(d, kept)
+
+	// Candidates are ready hosts that are not assigned yet. Hosts that are
+	// requested by ID are waited for, as they might still be attaching.
+	var requested []string
+	if v, ok := d.GetOk(hostPoolHostIDs); ok {
+		requested = flex.ExpandStringList(v.(*schema.Set).List())
+		hostList, err = waitForSatelliteHostPoolCandidates(satClient, location, requested, timeout)
+		if err != nil {
+			if conns.IsResourceTimeoutError(err) {
+				return &satelliteHostPoolIncompleteError{fmt.Errorf("[ERROR] Error waiting for the hosts of the host pool to attach to location %s: %s", location, err)}
+			}
+			return fmt.Errorf("[ERROR] Error waiting for the hosts of the host pool to attach to location %s: %s", location, err)
+		}
+	}
+	selector := expandSatelliteHostPoolLabels(d.Get(hostPoolSelector).(map[string]interface{}))
+	candidates := []kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, h := range hostList {
+		if len(requested) > 0 && !flex.StringContains(requested, flex.StringValue(h.ID)) && !flex.StringContains(requested, flex.StringValue(h.Name)) {
+			continue
+		}
+		if h.Assignment != nil && flex.StringValue(h.Assignment.ClusterID) != "" {
+			continue
+		}
+		if h.Health == nil || flex.StringValue(h.Health.Status) != rsHostReadyStatus {
+			continue
+		}
+		if !satelliteHostPoolLabelsMatch(h.Labels, selector) {
+			continue
+		}
+		candidates = append(candidates, h)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return flex.StringValue(candidates[i].Name) < flex.StringValue(candidates[j].Name)
+	})
+
+	// Fill the zones of each target in turn, preferring hosts whose zone
+	// label matches the zone
+	shortages := []string{}
+	newAssignments := []satelliteHostPoolAssignment{}
+	for i, t := range targets {
+		if t == nil {
+			continue
+		}
+		target := t.(map[string]interface{})
+		zones := flex.ExpandStringList(target["zones"].([]interface{}))
+		labels := expandSatelliteHostPoolLabels(target[hostLabels].(map[string]interface{}))
+		for _, zone := range zones {
+			key := fmt.Sprintf("%d/%s", i, zone)
+			for perZone[key] < satelliteHostPoolZoneCount(target["count"].(int), zones, zone) {
+				index := -1
+				for c, h := range candidates {
+					if !satelliteHostPoolLabelsMatch(h.Labels, labels) {
+						continue
+					}
+					if h.Labels[hostZone] == zone {
+						index = c
+						break
+					}
+					if index == -1 && (h.Labels[hostZone] == "" || !flex.StringContains(zones, h.Labels[hostZone])) {
+						index = c
+					}
+				}
+				if index == -1 {
+					shortages = append(shortages, fmt.Sprintf("target %d needs %d more host(s) in zone %s", i, satelliteHostPoolZoneCount(target["count"].(int), zones, zone)-perZone[key], zone))
+					break
+				}
+				h := candidates[index]
+				candidates = append(candidates[:index], candidates[index+1:]...)
+				perZone[key]++
+				newAssignments = append(newAssignments, satelliteHostPoolAssignment{
+					hostID:      flex.StringValue(h.ID),
+					hostName:    flex.StringValue(h.Name),
+					targetIndex: i,
+					cluster:     satelliteHostPoolCluster(target, location),
+					workerPool:  target[hostWorkerPool].(string),
+					zone:        zone,
+				})
+			}
+		}
+	}
+
+	for _, a := range newAssignments {
+		hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{
+			Controller: flex.PtrToString(location),
+			Cluster:    flex.PtrToString(a.cluster),
+			HostID:     flex.PtrToString(a.hostID),
+			Labels:     map[string]string{},
+			Workerpool: flex.PtrToString(a.workerPool),
+			Zone:       flex.PtrToString(a.zone),
+		}
+		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+		if err != nil {
+			setSatelliteHostPoolAssignments(d, kept)
+			return fmt.Errorf("[ERROR] Error Assigning Satellite Host %s: %s\n%s", a.hostID, err, response)
+		}
+		kept = append(kept, a)
+		setSatelliteHostPoolAssignments(d, kept)
+	}
+
+	if len(kept) > 0 {
+		_, err = waitForSatelliteHostPoolNormal(satClient, location, kept, timeout)
+		if err != nil {
+			if conns.IsResourceTimeoutError(err) {
+				return &satelliteHostPoolIncompleteError{fmt.Errorf("[ERROR] Error waiting for the hosts of the host pool to get normal state: %s", err)}
+			}
+			return fmt.Errorf("[ERROR] Error waiting for the hosts of the host pool to get normal state: %s", err)
+		}
+	}
+	if len(shortages) > 0 {
+		return &satelliteHostPoolIncompleteError{fmt.Errorf("[ERROR] Not enough candidate hosts in location %s: %s", location, strings.Join(shortages, "; "))}
+	}
+	return nil
+}
+
+func waitForSatelliteHostPoolCandidates(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string, requested []string, timeout time.Duration) ([]kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostProvisioningStatus},
+		Target:  []string{rsHostReadyStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the hosts of location %s: %s\n%s", location, err, resp)
+			}
+			for _, r := range requested {
+				attached := false
+				for _, h := range hostList {
+					if r != flex.StringValue(h.ID) && r != flex.StringValue(h.Name) {
+						continue
+					}
+					if h.Health != nil && flex.StringValue(h.Health.Status) != rsHostProvisioningStatus && flex.StringValue(h.Health.Status) != rsHostUnknownStatus {
+						attached = true
+					}
+				}
+				if !attached {
+					log.Printf("[DEBUG] Waiting for host %s to attach to location %s", r, location)
+					return hostList, rsHostProvisioningStatus, nil
+				}
+			}
+			return hostList, rsHostReadyStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+
+	hostList, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return hostList.([]kubernetesserviceapiv1.MultishiftQueueNode), nil
+}
+
+func waitForSatelliteHostPoolNormal(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string, assignments []satelliteHostPoolAssignment, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostProvisioningStatus},
+		Target:  []string{rsHostNormalStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the hosts of location %s: %s\n%s", location, err, resp)
+			}
+			status := map[string]string{}
+			for _, h := range hostList {
+				if h.Health != nil {
+					status[flex.StringValue(h.ID)] = flex.StringValue(h.Health.Status)
+				}
+			}
+			for _, a := range assignments {
+				if status[a.hostID] != rsHostNormalStatus {
+					log.Printf("[DEBUG] Host %s state: %s", a.hostID, status[a.hostID])
+					return hostList, rsHostProvisioningStatus, nil
+				}
+			}
+			return hostList, rsHostNormalStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// releaseSatelliteHostPoolHost releases a host that the pool no longer needs.
+// Satellite cannot unassign a host, so the host stays assigned and is only
+// removed from the location when remove_released_hosts is set.
+func releaseSatelliteHostPoolHost(d *schema.ResourceData, satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location, hostID string) error {
+	if !d.Get(hostPoolRemoveHosts).(bool) {
+		log.Printf("[WARN] Releasing host %s of location %s, it stays assigned and is no longer managed by the host pool", hostID, location)
+		return nil
+	}
+	log.Printf("[INFO] Removing host %s from location %s as it is no longer needed by the host pool", hostID, location)
+	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{
+		Controller: &location,
+		HostID:     &hostID,
+	}
+	response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Deleting Satellite Host %s: %s\n%s", hostID, err, response)
+	}
+	return nil
+}
+
+// satelliteHostPoolZoneCount returns the number of hosts of a target in a
+// zone. The remainder of count is given to the first zones.
+func satelliteHostPoolZoneCount(count int, zones []string, zone string) int {
+	for i, z := range zones {
+		if z == zone {
+			n := count / len(zones)
+			if i < count%len(zones) {
+				n++
+			}
+			return n
+		}
+	}
+	return 0
+}
+
+func satelliteHostPoolCluster(target map[string]interface{}, location string) string {
+	if cluster := target[hostCluster].(string); cluster != "" {
+		return cluster
+	}
+	return location
+}
+
+func satelliteHostPoolLabelsMatch(hostLabels, required map[string]string) bool {
+	for k, v := range required {
+		if hostLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func expandSatelliteHostPoolAssignments(d *schema.ResourceData) []satelliteHostPoolAssignment {
+	assignments := []satelliteHostPoolAssignment{}
+	for _, v := range d.Get(hostPoolAssignments).([]interface{}) {
+		a := v.(map[string]interface{})
+		assignments = append(assignments, satelliteHostPoolAssignment{
+			hostID:      a[hostID].(string),
+			hostName:    a["host_name"].(string),
+			targetIndex: a["target_index"].(int),
+			cluster:     a[hostCluster].(string),
+			workerPool:  a[hostWorkerPool].(string),
+			zone:        a[hostZone].(string),
+			state:       a[hostState].(string),
+		})
+	}
+	return assignments
+}
+
+func flattenSatelliteHostPoolAssignment(a satelliteHostPoolAssignment) map[string]interface{} {
+	return map[string]interface{}{
+		hostID:         a.hostID,
+		"host_name":    a.hostName,
+		"target_index": a.targetIndex,
+		hostCluster:    a.cluster,
+		hostWorkerPool: a.workerPool,
+		hostZone:       a.zone,
+		hostState:      a.state,
+	}
+}
+
+func setSatelliteHostPoolAssignments(d *schema.ResourceData, assignments []satelliteHostPoolAssignment) {
+	flattened := make([]map[string]interface{}, 0, len(assignments))
+	for _, a := range assignments {
+		flattened = append(flattened, flattenSatelliteHostPoolAssignment(a))
+	}
+	d.Set(hostPoolAssignments, flattened)
+}
+
+func expandSatelliteHostPoolLabels(m map[string]interface{}) map[string]string {
+	labels := make(map[string]string, len(m))
+	for k, v := range m {
+		labels[k] = v.(string)
+	}
+	return labels
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFunctionSatelliteHostPool_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource_prefix := "tf-satellite"
+	rhel_image_name := "ibm-redhat-8-8-minimal-amd64-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckSatelliteHostPoolDestroy,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckSatelliteHostPoolCreate(name, resource_prefix, rhel_image_name, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSatelliteHostPoolNormal("ibm_satellite_host_pool.control_plane"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_pool.control_plane", "assignments.#", "3"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_pool.control_plane", "assignments.0.host_state", "normal"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionSatelliteHostPool_Shortage(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource_prefix := "tf-satellite"
+	rhel_image_name := "ibm-redhat-8-8-minimal-amd64-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckSatelliteHostPoolDestroy,
+		Steps: []resource.TestStep{
+
+			{
+				// Only 3 hosts are attached, the pool is created with a
+				// warning and the next plan resumes the assignment
+				Config: testAccCheckSatelliteHostPoolCreate(name, resource_prefix, rhel_image_name, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_pool.control_plane", "assignments.#", "3"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_pool.control_plane", "assignments.0.host_state", "normal"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckSatelliteHostPoolCreate(name, resource_prefix, rhel_image_name, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSatelliteHostPoolNormal("ibm_satellite_host_pool.control_plane"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_pool.control_plane", "assignments.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteHostPoolNormal(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ID := parts[0]
+		getSatOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+			Controller: &ID,
+		}
+
+		hostList, resp, err := satClient.GetSatelliteHosts(getSatOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving satellite hosts: %s\n Response code is: %+v", err, resp)
+		}
+
+		zones := map[string]bool{}
+		for _, h := range hostList {
+			if h.Health == nil || *h.Health.Status != "normal" {
+				return fmt.Errorf("Host %s is not normal", *h.Name)
+			}
+			if h.Assignment != nil && h.Assignment.Zone != nil {
+				zones[*h.Assignment.Zone] = true
+			}
+		}
+		if len(zones) != 3 {
+			return fmt.Errorf("Hosts are not spread across the location zones: %v", zones)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSatelliteHostPoolDestroy(s *terraform.State) error {
+	satClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_satellite_host_pool" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		ID := parts[0]
+
+		hostList, _, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+			Controller: &ID,
+		})
+		if err == nil && len(hostList) > 0 {
+			return fmt.Errorf("Satellite hosts still exist in location: %s", ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckSatelliteHostPoolCreate(name, resource_prefix, rhel_image_name string, count int) string {
+	return fmt.Sprintf(`
+	variable "location_zones" {
+		description = "Allocate your hosts across these three zones"
+		type        = list(string)
+		default     = ["location-zone-1", "location-zone-2", "location-zone-3"]
+	  }
+	  
+	  resource "ibm_satellite_location" "location" {
+		location     = "%[1]s"
+		managed_from = "dal"
+		zones        = var.location_zones
+	  }
+	  
+	  data "ibm_satellite_attach_host_script" "script" {
+		location      = ibm_satellite_location.location.id
+		host_provider = "ibm"
+		labels        = ["cpu:8", "memory:64"]
+	  }
+	  
+	  data "ibm_resource_group" "resource_group" {
+		is_default = true
+	  }
+	  
+	  resource "ibm_is_vpc" "satellite_vpc" {
+		name                        = "%[2]s-vpc-1"
+		resource_group              = data.ibm_resource_group.resource_group.id
+		default_security_group_name = "%[2]s-default-sg"
+		default_network_acl_name    = "%[2]s-default-acl"
+		default_routing_table_name  = "%[2]s-default-rt"
+	  }
+	  
+	  resource "ibm_is_subnet" "satellite_subnet" {
+		count = 3
+	  
+		name                     = "%[2]s-subnet-${count.index}"
+		vpc                      = ibm_is_vpc.satellite_vpc.id
+		total_ipv4_address_count = 256
+		zone                     = "us-south-${count.index + 1}"
+	  }
+	  
+	  data "ibm_is_image" "rhel8" {
+		name = "%[3]s"
+	  }
+	  
+	  resource "ibm_is_instance" "satellite_instance" {
+		count = 3
+	  
+		name           = "%[2]s-instance-${count.index}"
+		vpc            = ibm_is_vpc.satellite_vpc.id
+		zone           = "us-south-${count.index + 1}"
+		image          = data.ibm_is_image.rhel8.id
+		profile        = "mx2-8x64"
+		keys           = []
+		resource_group = data.ibm_resource_group.resource_group.id
+		user_data      = data.ibm_satellite_attach_host_script.script.host_script
+	  
+		primary_network_interface {
+		  name   = "eth0"
+		  subnet = ibm_is_subnet.satellite_subnet[count.index].id
+		}
+	  }
+	  
+	  resource "ibm_satellite_host_pool" "control_plane" {
+		location              = ibm_satellite_location.location.id
+		host_ids              = ibm_is_instance.satellite_instance[*].name
+		remove_released_hosts = true
+	  
+		targets {
+		  zones  = var.location_zones
+		  count  = %[4]d
+		  labels = {
+			cpu = "8"
+		  }
+		}
+	  }
+`, name, resource_prefix, rhel_image_name, count)
+}
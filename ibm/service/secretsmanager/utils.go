@@ -132,6 +132,21 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// GetClientForInstance returns a Secrets Manager client for the given instance
+// and region, for services that read a secret referenced by its CRN. The
+// endpoint type follows the visibility of the provider configuration.
+func GetClientForInstance(clientSession conns.ClientSession, instanceId string, region string) (*secretsmanagerv2.SecretsManagerV2, error) {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, err
+	}
+	endpointType := "public"
+	if strings.Contains(secretsManagerClient.Service.GetServiceURL(), "private.") {
+		endpointType = "private"
+	}
+	return getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, endpointType, endpointsFile), nil
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	isInstanceUserDataPart          = "part"
+	isInstanceUserDataWriteFiles    = "write_files"
+	isInstanceUserDataGzip          = "gzip"
+	isInstanceUserDataBase64Encode  = "base64_encode"
+	isInstanceUserDataBoundary      = "boundary"
+	isInstanceUserDataRendered      = "rendered"
+	isInstanceUserDataSize          = "size"
+	isInstanceUserDataCloudConfig   = "text/cloud-config"
+	isInstanceUserDataShellScript   = "text/x-shellscript"
+	isInstanceUserDataWriteFilesMrg = "list(append)+dict(no_replace,recurse_list)+str()"
+
+	// isInstanceUserDataMaxSize is the largest user data payload accepted by
+	// the VPC instance and instance template APIs.
+	isInstanceUserDataMaxSize = 64 * 1024
+)
+
+func DataSourceIBMIsInstanceUserData() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsInstanceUserDataRead,
+
+		Schema: map[string]*schema.Schema{
+			isInstanceUserDataGzip: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to gzip the rendered multipart document. Requires base64_encode.",
+			},
+			isInstanceUserDataBase64Encode: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to base64 encode the rendered document.",
+			},
+			isInstanceUserDataBoundary: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MIMEBOUNDARY",
+				ValidateFunc: validation.StringLenBetween(1, 70),
+				Description:  "The MIME boundary used between the parts of the multipart document.",
+			},
+			isInstanceUserDataPart: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A cloud-init part of the multipart document, rendered in the order given.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  isInstanceUserDataCloudConfig,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{
+								isInstanceUserDataCloudConfig, isInstanceUserDataShellScript, "text/cloud-boothook",
+								"text/part-handler", "text/jinja2", "text/x-include-url",
+							}),
+							Description: "The MIME content type of the part.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The content of the part. text/cloud-config parts are validated as YAML.",
+						},
+						"filename": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The filename reported in the Content-Disposition header of the part.",
+						},
+						"merge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The cloud-init Merge-Type header used when combining cloud-config parts.",
+						},
+					},
+				},
+			},
+			isInstanceUserDataWriteFiles: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Files written by cloud-init, rendered as an additional text/cloud-config part.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The absolute path of the file on the instance.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The content of the file.",
+						},
+						"permissions": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The octal file mode, for example `0644`.",
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The owner of the file in `user:group` form.",
+						},
+						"encoding": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"b64", "gzip+b64", "text/plain"}),
+							Description:  "The encoding of content as understood by cloud-init.",
+						},
+						"append": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to append to an existing file instead of replacing it.",
+						},
+					},
+				},
+			},
+			isInstanceUserDataRendered: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered user data, ready to be used as user_data of ibm_is_instance or ibm_is_instance_template.",
+			},
+			isInstanceUserDataSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size in bytes of the rendered user data.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsInstanceUserDataRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gz := d.Get(isInstanceUserDataGzip).(bool)
+	b64 := d.Get(isInstanceUserDataBase64Encode).(bool)
+	if gz && !b64 {
+		return diag.FromErr(fmt.Errorf("[ERROR] %s requires %s, the VPC API only accepts text user data", isInstanceUserDataGzip, isInstanceUserDataBase64Encode))
+	}
+
+	parts := []isInstanceUserDataMIMEPart{}
+	for _, p := range d.Get(isInstanceUserDataPart).([]interface{}) {
+		part := p.(map[string]interface{})
+		parts = append(parts, isInstanceUserDataMIMEPart{
+			contentType: part["content_type"].(string),
+			content:     part["content"].(string),
+			filename:    part["filename"].(string),
+			mergeType:   part["merge_type"].(string),
+		})
+	}
+	if files := d.Get(isInstanceUserDataWriteFiles).([]interface{}); len(files) > 0 {
+		part, err := isInstanceUserDataWriteFilesPart(files)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] at least one %s or %s block must be specified", isInstanceUserDataPart, isInstanceUserDataWriteFiles))
+	}
+
+	rendered, err := renderIsInstanceUserData(parts, d.Get(isInstanceUserDataBoundary).(string), gz, b64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(rendered) > isInstanceUserDataMaxSize {
+		return diag.FromErr(fmt.Errorf("[ERROR] rendered user data is %d bytes which exceeds the VPC limit of %d bytes", len(rendered), isInstanceUserDataMaxSize))
+	}
+
+	sum := sha256.Sum256([]byte(rendered))
+	d.SetId(hex.EncodeToString(sum[:]))
+	if err = d.Set(isInstanceUserDataRendered, rendered); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rendered: %s", err))
+	}
+	if err = d.Set(isInstanceUserDataSize, len(rendered)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting size: %s", err))
+	}
+	return nil
+}
+
+type isInstanceUserDataMIMEPart struct {
+	contentType string
+	content     string
+	filename    string
+	mergeType   string
+}
+
+// isInstanceUserDataWriteFilesPart renders write_files blocks as a cloud-config
+// part that merges with any write_files already declared in other parts.
+func isInstanceUserDataWriteFilesPart(files []interface{}) (isInstanceUserDataMIMEPart, error) {
+	entries := make([]map[string]interface{}, 0, len(files))
+	for _, f := range files {
+		file := f.(map[string]interface{})
+		entry := map[string]interface{}{
+			"path":    file["path"].(string),
+			"content": file["content"].(string),
+		}
+		for _, key := range []string{"permissions", "owner", "encoding"} {
+			if v := file[key].(string); v != "" {
+				entry[key] = v
+			}
+		}
+		if file["append"].(bool) {
+			entry["append"] = true
+		}
+		entries = append(entries, entry)
+	}
+	out, err := yaml.Marshal(map[string]interface{}{"write_files": entries})
+	if err != nil {
+		return isInstanceUserDataMIMEPart{}, fmt.Errorf("[ERROR] Error rendering write_files: %s", err)
+	}
+	return isInstanceUserDataMIMEPart{
+		contentType: isInstanceUserDataCloudConfig,
+		content:     "#cloud-config\n" + string(out),
+		filename:    "write-files.cfg",
+		mergeType:   isInstanceUserDataWriteFilesMrg,
+	}, nil
+}
+
+// renderIsInstanceUserData validates each part and renders them as a
+// multipart/mixed document understood by cloud-init.
+func renderIsInstanceUserData(parts []isInstanceUserDataMIMEPart, boundary string, gz, b64 bool) (string, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(boundary); err != nil {
+		return "", fmt.Errorf("[ERROR] Invalid boundary %q: %s", boundary, err)
+	}
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+
+	for i, part := range parts {
+		switch part.contentType {
+		case isInstanceUserDataCloudConfig:
+			var doc map[string]interface{}
+			if err := yaml.Unmarshal([]byte(part.content), &doc); err != nil {
+				return "", fmt.Errorf("[ERROR] part %d is not valid cloud-config YAML: %s", i, err)
+			}
+		case isInstanceUserDataShellScript:
+			if !strings.HasPrefix(part.content, "#!") {
+				return "", fmt.Errorf("[ERROR] part %d is a shell script and must start with an interpreter line such as #!/bin/bash", i)
+			}
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", part.contentType))
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("MIME-Version", "1.0")
+		if part.filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", part.filename))
+		}
+		if part.mergeType != "" {
+			header.Set("Merge-Type", part.mergeType)
+		}
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error writing part %d: %s", i, err)
+		}
+		if _, err = pw.Write([]byte(part.content)); err != nil {
+			return "", fmt.Errorf("[ERROR] Error writing part %d: %s", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("[ERROR] Error closing multipart document: %s", err)
+	}
+
+	out := buf.Bytes()
+	if gz {
+		var zbuf bytes.Buffer
+		zw := gzip.NewWriter(&zbuf)
+		if _, err := zw.Write(out); err != nil {
+			return "", fmt.Errorf("[ERROR] Error compressing user data: %s", err)
+		}
+		if err := zw.Close(); err != nil {
+			return "", fmt.Errorf("[ERROR] Error compressing user data: %s", err)
+		}
+		out = zbuf.Bytes()
+	}
+	if b64 {
+		return base64.StdEncoding.EncodeToString(out), nil
+	}
+	return string(out), nil
+}
+
+// decodeIsInstanceUserData strips base64 and gzip layers from user data so that
+// differently encoded copies of the same content compare equal.
+func decodeIsInstanceUserData(userData string) string {
+	data := []byte(userData)
+	if raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(userData)); err == nil && len(raw) > 0 {
+		if isGzipData(raw) || utf8.Valid(raw) {
+			data = raw
+		}
+	}
+	if isGzipData(data) {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return userData
+		}
+		defer zr.Close()
+		raw, err := io.ReadAll(zr)
+		if err != nil {
+			return userData
+		}
+		data = raw
+	}
+	return string(data)
+}
+
+func isGzipData(data []byte) bool {
+	return len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// suppressUserDataEncodingDiff suppresses the diff between user data values that
+// only differ in their base64/gzip encoding.
+func suppressUserDataEncodingDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	if old == "" || new == "" {
+		return false
+	}
+	return decodeIsInstanceUserData(old) == decodeIsInstanceUserData(new)
+}
+
+// validateIsInstanceUserDataSize rejects user data larger than the VPC limit at plan time.
+func validateIsInstanceUserDataSize(v interface{}, k string) (ws []string, errors []error) {
+	userData := v.(string)
+	if len(userData) > isInstanceUserDataMaxSize {
+		errors = append(errors, fmt.Errorf("%q is %d bytes which exceeds the VPC limit of %d bytes, consider rendering it with the ibm_is_instance_user_data data source using gzip", k, len(userData), isInstanceUserDataMaxSize))
+	}
+	return
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsInstanceUserDataDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsInstanceUserDataDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_instance_user_data.example", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_instance_user_data.example", "rendered"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_instance_user_data.example", "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsInstanceUserDataDataSourcePlain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsInstanceUserDataDataSourceConfigPlain(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.ibm_is_instance_user_data.example", "rendered", regexp.MustCompile("Content-Type: multipart/mixed")),
+					resource.TestMatchResourceAttr("data.ibm_is_instance_user_data.example", "rendered", regexp.MustCompile("write_files")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsInstanceUserDataDataSourceInvalidYAML(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIsInstanceUserDataDataSourceConfigInvalidYAML(),
+				ExpectError: regexp.MustCompile("not valid cloud-config YAML"),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsInstanceUserDataDataSourceTooLarge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIsInstanceUserDataDataSourceConfigTooLarge(),
+				ExpectError: regexp.MustCompile("exceeds the VPC limit"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsInstanceUserDataDataSourceConfigBasic() string {
+	return `
+	data "ibm_is_instance_user_data" "example" {
+		part {
+			content_type = "text/cloud-config"
+			content      = "#cloud-config\npackages:\n  - nginx\n"
+		}
+		part {
+			content_type = "text/x-shellscript"
+			content      = "#!/bin/bash\necho hello\n"
+		}
+	}
+	`
+}
+
+func testAccCheckIBMIsInstanceUserDataDataSourceConfigPlain() string {
+	return `
+	data "ibm_is_instance_user_data" "example" {
+		gzip          = false
+		base64_encode = false
+		write_files {
+			path        = "/etc/motd"
+			content     = "hello"
+			permissions = "0644"
+		}
+	}
+	`
+}
+
+func testAccCheckIBMIsInstanceUserDataDataSourceConfigInvalidYAML() string {
+	return `
+	data "ibm_is_instance_user_data" "example" {
+		part {
+			content = "#cloud-config\npackages: [nginx\n"
+		}
+	}
+	`
+}
+
+func testAccCheckIBMIsInstanceUserDataDataSourceConfigTooLarge() string {
+	return fmt.Sprintf(`
+	data "ibm_is_instance_user_data" "example" {
+		gzip          = false
+		base64_encode = false
+		part {
+			content_type = "text/x-shellscript"
+			content      = "#!/bin/bash\n# %s\n"
+		}
+	}
+	`, strings.Repeat("x", 70000))
+}
@@ -0,0 +1,819 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkPathAllowed       = "allowed"
+	isNetworkPathVerdict       = "verdict"
+	isNetworkPathReason        = "reason"
+	isNetworkPathSteps         = "steps"
+	isNetworkPathResultAllowed = "allowed"
+	isNetworkPathResultDenied  = "denied"
+	isNetworkPathResultUnknown = "indeterminate"
+	isNetworkPathResultSkipped = "skipped"
+)
+
+func DataSourceIBMIsNetworkPathAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsNetworkPathAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_instance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_instance", "source_virtual_network_interface", "source_subnet"},
+				Description:  "The virtual server instance the traffic originates from. Its primary network interface or attachment is evaluated.",
+			},
+			"source_virtual_network_interface": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_instance", "source_virtual_network_interface", "source_subnet"},
+				Description:  "The virtual network interface the traffic originates from.",
+			},
+			"source_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_instance", "source_virtual_network_interface", "source_subnet"},
+				RequiredWith: []string{"source_ip"},
+				Description:  "The subnet the traffic originates from. Requires source_ip.",
+			},
+			"source_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The source IP address. Defaults to the primary IP of the source instance or virtual network interface.",
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_path_analysis", "source_port"),
+				Description:  "The source port of the flow. When omitted, network ACL rules that restrict source ports are reported as indeterminate.",
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"destination_ip", "destination_instance", "destination_virtual_network_interface"},
+				Description:  "The destination IP address.",
+			},
+			"destination_instance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"destination_ip", "destination_instance", "destination_virtual_network_interface"},
+				Description:  "The destination virtual server instance. Its security groups are evaluated for the inbound traffic.",
+			},
+			"destination_virtual_network_interface": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"destination_ip", "destination_instance", "destination_virtual_network_interface"},
+				Description:  "The destination virtual network interface. Its security groups are evaluated for the inbound traffic.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_path_analysis", "protocol"),
+				Description:  "The protocol of the flow, one of `tcp`, `udp` or `icmp`.",
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_path_analysis", "destination_port"),
+				Description:  "The destination port of the flow. Required for `tcp` and `udp`.",
+			},
+			"icmp_type": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ICMP type of the flow.",
+			},
+			"icmp_code": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ICMP code of the flow.",
+			},
+			isNetworkPathAllowed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every evaluated stage allows the flow.",
+			},
+			isNetworkPathVerdict: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The overall result, one of `allowed`, `denied` or `indeterminate`.",
+			},
+			isNetworkPathReason: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The stage and rule that decided the verdict.",
+			},
+			isNetworkPathSteps: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The evaluated stages in the order the traffic traverses them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stage": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The evaluated stage.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the evaluated resource.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the evaluated resource.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the evaluated resource.",
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the rule or route that decided the stage.",
+						},
+						"rule_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule or route that decided the stage.",
+						},
+						"result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the stage, one of `allowed`, `denied`, `indeterminate` or `skipped`.",
+						},
+						"detail": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A description of the decision.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIsNetworkPathAnalysisValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "protocol",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "icmp, tcp, udp"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "destination_port",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "source_port",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+
+	ibmISNetworkPathAnalysisDataSourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_path_analysis", Schema: validateSchema}
+	return &ibmISNetworkPathAnalysisDataSourceValidator
+}
+
+// networkPathEndpoint is one end of the analysed flow.
+type networkPathEndpoint struct {
+	resourceType   string
+	id             string
+	ip             net.IP
+	subnet         *vpcv1.Subnet
+	securityGroups []vpcv1.SecurityGroupReference
+	// floatingIPs is nil when the floating IPs of the endpoint are not known.
+	floatingIPs []string
+}
+
+// networkPathFlow is the analysed traffic. A zero port is unknown.
+type networkPathFlow struct {
+	protocol string
+	srcIP    net.IP
+	dstIP    net.IP
+	srcPort  int64
+	dstPort  int64
+	icmpType *int64
+	icmpCode *int64
+}
+
+func (f networkPathFlow) reverse() networkPathFlow {
+	return networkPathFlow{
+		protocol: f.protocol,
+		srcIP:    f.dstIP,
+		dstIP:    f.srcIP,
+		srcPort:  f.dstPort,
+		dstPort:  f.srcPort,
+		icmpType: f.icmpType,
+		icmpCode: f.icmpCode,
+	}
+}
+
+// networkPathRule holds the attributes shared by all security group and network
+// ACL rule variants returned by the VPC API.
+type networkPathRule struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Action             string `json:"action"`
+	Direction          string `json:"direction"`
+	Protocol           string `json:"protocol"`
+	Source             string `json:"source"`
+	Destination        string `json:"destination"`
+	PortMin            *int64 `json:"port_min"`
+	PortMax            *int64 `json:"port_max"`
+	SourcePortMin      *int64 `json:"source_port_min"`
+	SourcePortMax      *int64 `json:"source_port_max"`
+	DestinationPortMin *int64 `json:"destination_port_min"`
+	DestinationPortMax *int64 `json:"destination_port_max"`
+	Type               *int64 `json:"type"`
+	Code               *int64 `json:"code"`
+	Remote             *struct {
+		Address   string `json:"address"`
+		CIDRBlock string `json:"cidr_block"`
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+	} `json:"remote"`
+	Local *struct {
+		Address   string `json:"address"`
+		CIDRBlock string `json:"cidr_block"`
+	} `json:"local"`
+}
+
+type networkPathStep struct {
+	stage        string
+	resourceType string
+	resourceID   string
+	resourceName string
+	ruleID       string
+	ruleName     string
+	result       string
+	detail       string
+}
+
+func dataSourceIBMIsNetworkPathAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	source, err := networkPathResolveEndpoint(context, sess, d.Get("source_instance").(string), d.Get("source_virtual_network_interface").(string), d.Get("source_subnet").(string), d.Get("source_ip").(string))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error resolving the source: %s", err.Error()), "(Data) ibm_is_network_path_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	destination, err := networkPathResolveEndpoint(context, sess, d.Get("destination_instance").(string), d.Get("destination_virtual_network_interface").(string), "", d.Get("destination_ip").(string))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error resolving the destination: %s", err.Error()), "(Data) ibm_is_network_path_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if destination.subnet == nil {
+		// a bare IP address is inside the VPC when one of its subnets contains it
+		destination.subnet, err = networkPathFindSubnet(context, sess, *source.subnet.VPC.ID, destination.ip)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error listing subnets: %s", err.Error()), "(Data) ibm_is_network_path_analysis", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	flow := networkPathFlow{
+		protocol: d.Get("protocol").(string),
+		srcIP:    source.ip,
+		dstIP:    destination.ip,
+		srcPort:  int64(d.Get("source_port").(int)),
+		dstPort:  int64(d.Get("destination_port").(int)),
+	}
+	if flow.protocol != "icmp" && flow.dstPort == 0 {
+		err = fmt.Errorf("destination_port is required for protocol %s", flow.protocol)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_network_path_analysis", "read", "validate-port").GetDiag()
+	}
+	if v, ok := d.GetOkExists("icmp_type"); ok {
+		flow.icmpType = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("icmp_code"); ok {
+		flow.icmpCode = core.Int64Ptr(int64(v.(int)))
+	}
+
+	steps, err := networkPathAnalyse(context, sess, source, destination, flow)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error analysing the network path: %s", err.Error()), "(Data) ibm_is_network_path_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	verdict, reason := networkPathVerdict(steps)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%d", source.id, flow.srcIP, flow.protocol, flow.dstIP, flow.dstPort))
+	if err = d.Set("source_ip", flow.srcIP.String()); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting source_ip: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-source_ip").GetDiag()
+	}
+	if err = d.Set("destination_ip", flow.dstIP.String()); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting destination_ip: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-destination_ip").GetDiag()
+	}
+	if err = d.Set(isNetworkPathAllowed, verdict == isNetworkPathResultAllowed); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting allowed: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-allowed").GetDiag()
+	}
+	if err = d.Set(isNetworkPathVerdict, verdict); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting verdict: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-verdict").GetDiag()
+	}
+	if err = d.Set(isNetworkPathReason, reason); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting reason: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-reason").GetDiag()
+	}
+	stepList := make([]map[string]interface{}, 0, len(steps))
+	for _, step := range steps {
+		stepList = append(stepList, map[string]interface{}{
+			"stage":         step.stage,
+			"resource_type": step.resourceType,
+			"resource_id":   step.resourceID,
+			"resource_name": step.resourceName,
+			"rule_id":       step.ruleID,
+			"rule_name":     step.ruleName,
+			"result":        step.result,
+			"detail":        step.detail,
+		})
+	}
+	if err = d.Set(isNetworkPathSteps, stepList); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting steps: %s", err), "(Data) ibm_is_network_path_analysis", "read", "set-steps").GetDiag()
+	}
+	return nil
+}
+
+// networkPathResolveEndpoint looks up the subnet, security groups and IP address
+// of an instance, virtual network interface, subnet or bare IP address.
+func networkPathResolveEndpoint(context context.Context, sess *vpcv1.VpcV1, instanceID, vniID, subnetID, ip string) (*networkPathEndpoint, error) {
+	endpoint := &networkPathEndpoint{}
+	switch {
+	case instanceID != "":
+		instance, response, err := sess.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{ID: &instanceID})
+		if err != nil {
+			return nil, fmt.Errorf("GetInstanceWithContext failed: %s\n%s", err, response)
+		}
+		endpoint.resourceType, endpoint.id = "instance", instanceID
+		if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.VirtualNetworkInterface != nil {
+			vniID = *instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID
+			break
+		}
+		if instance.PrimaryNetworkInterface == nil {
+			return nil, fmt.Errorf("instance %s has no primary network interface", instanceID)
+		}
+		nic, response, err := sess.GetInstanceNetworkInterfaceWithContext(context, &vpcv1.GetInstanceNetworkInterfaceOptions{
+			InstanceID: &instanceID,
+			ID:         instance.PrimaryNetworkInterface.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("GetInstanceNetworkInterfaceWithContext failed: %s\n%s", err, response)
+		}
+		endpoint.securityGroups = nic.SecurityGroups
+		endpoint.floatingIPs = []string{}
+		for _, fip := range nic.FloatingIps {
+			endpoint.floatingIPs = append(endpoint.floatingIPs, *fip.Address)
+		}
+		subnetID = *nic.Subnet.ID
+		if ip == "" && nic.PrimaryIP != nil && nic.PrimaryIP.Address != nil {
+			ip = *nic.PrimaryIP.Address
+		}
+	case vniID != "":
+		endpoint.resourceType, endpoint.id = "virtual_network_interface", vniID
+	case subnetID != "":
+		endpoint.resourceType, endpoint.id = "subnet", subnetID
+	default:
+		endpoint.resourceType, endpoint.id = "ip", ip
+	}
+
+	if vniID != "" {
+		vni, response, err := sess.GetVirtualNetworkInterfaceWithContext(context, &vpcv1.GetVirtualNetworkInterfaceOptions{ID: &vniID})
+		if err != nil {
+			return nil, fmt.Errorf("GetVirtualNetworkInterfaceWithContext failed: %s\n%s", err, response)
+		}
+		endpoint.securityGroups = vni.SecurityGroups
+		subnetID = *vni.Subnet.ID
+		if ip == "" && vni.PrimaryIP != nil && vni.PrimaryIP.Address != nil {
+			ip = *vni.PrimaryIP.Address
+		}
+	}
+
+	endpoint.ip = net.ParseIP(ip)
+	if endpoint.ip == nil || endpoint.ip.To4() == nil {
+		return nil, fmt.Errorf("%q is not a valid IPv4 address", ip)
+	}
+
+	if subnetID != "" {
+		subnet, response, err := sess.GetSubnetWithContext(context, &vpcv1.GetSubnetOptions{ID: &subnetID})
+		if err != nil {
+			return nil, fmt.Errorf("GetSubnetWithContext failed: %s\n%s", err, response)
+		}
+		if !networkPathCIDRContains(*subnet.Ipv4CIDRBlock, endpoint.ip) {
+			return nil, fmt.Errorf("IP address %s is not in subnet %s (%s)", endpoint.ip, *subnet.ID, *subnet.Ipv4CIDRBlock)
+		}
+		endpoint.subnet = subnet
+	}
+	return endpoint, nil
+}
+
+func networkPathFindSubnet(context context.Context, sess *vpcv1.VpcV1, vpcID string, ip net.IP) (*vpcv1.Subnet, error) {
+	pager, err := sess.NewSubnetsPager(&vpcv1.ListSubnetsOptions{VPCID: &vpcID})
+	if err != nil {
+		return nil, err
+	}
+	subnets, err := pager.GetAllWithContext(context)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subnets {
+		if subnets[i].Ipv4CIDRBlock != nil && networkPathCIDRContains(*subnets[i].Ipv4CIDRBlock, ip) {
+			return &subnets[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// networkPathAnalyse evaluates the stages of the flow in the order the packets
+// traverse them, followed by the return path through the stateless network ACLs.
+func networkPathAnalyse(context context.Context, sess *vpcv1.VpcV1, source, destination *networkPathEndpoint, flow networkPathFlow) ([]networkPathStep, error) {
+	steps := []networkPathStep{}
+	sameSubnet := destination.subnet != nil && *destination.subnet.ID == *source.subnet.ID
+
+	if len(source.securityGroups) > 0 {
+		groups, err := networkPathGetSecurityGroups(context, sess, source.securityGroups)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, networkPathEvaluateSecurityGroups("security_group_egress", groups, "outbound", flow, destination))
+	}
+
+	aclStep := func(stage string, subnet *vpcv1.Subnet, direction string, f networkPathFlow) error {
+		if sameSubnet {
+			steps = append(steps, networkPathStep{stage: stage, resourceType: "network_acl", result: isNetworkPathResultSkipped, detail: "network ACLs do not filter traffic within a subnet"})
+			return nil
+		}
+		acl, response, err := sess.GetNetworkACLWithContext(context, &vpcv1.GetNetworkACLOptions{ID: subnet.NetworkACL.ID})
+		if err != nil {
+			return fmt.Errorf("GetNetworkACLWithContext failed: %s\n%s", err, response)
+		}
+		step, err := networkPathEvaluateNetworkACL(stage, acl, direction, f)
+		if err != nil {
+			return err
+		}
+		steps = append(steps, step)
+		return nil
+	}
+
+	if err := aclStep("network_acl_egress", source.subnet, "outbound", flow); err != nil {
+		return nil, err
+	}
+
+	routeStep, err := networkPathEvaluateRoute(context, sess, source, destination, flow)
+	if err != nil {
+		return nil, err
+	}
+	steps = append(steps, routeStep)
+
+	if destination.subnet != nil {
+		if err := aclStep("network_acl_ingress", destination.subnet, "inbound", flow); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(destination.securityGroups) > 0:
+		groups, err := networkPathGetSecurityGroups(context, sess, destination.securityGroups)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, networkPathEvaluateSecurityGroups("security_group_ingress", groups, "inbound", flow, source))
+	case destination.subnet != nil:
+		steps = append(steps, networkPathStep{stage: "security_group_ingress", resourceType: "security_group", result: isNetworkPathResultUnknown, detail: "the security groups of the destination are unknown, set destination_instance or destination_virtual_network_interface to evaluate them"})
+	}
+
+	// network ACLs are stateless, so the reply has to be allowed explicitly
+	reply := flow.reverse()
+	if destination.subnet != nil {
+		if err := aclStep("network_acl_return_egress", destination.subnet, "outbound", reply); err != nil {
+			return nil, err
+		}
+	}
+	if err := aclStep("network_acl_return_ingress", source.subnet, "inbound", reply); err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
+func networkPathGetSecurityGroups(context context.Context, sess *vpcv1.VpcV1, refs []vpcv1.SecurityGroupReference) ([]*vpcv1.SecurityGroup, error) {
+	groups := make([]*vpcv1.SecurityGroup, 0, len(refs))
+	for _, ref := range refs {
+		group, response, err := sess.GetSecurityGroupWithContext(context, &vpcv1.GetSecurityGroupOptions{ID: ref.ID})
+		if err != nil {
+			return nil, fmt.Errorf("GetSecurityGroupWithContext failed: %s\n%s", err, response)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// networkPathEvaluateSecurityGroups allows the flow when any rule of any of the
+// groups matches it, security groups have no deny rules.
+func networkPathEvaluateSecurityGroups(stage string, groups []*vpcv1.SecurityGroup, direction string, flow networkPathFlow, peer *networkPathEndpoint) networkPathStep {
+	localIP, peerIP := flow.srcIP, flow.dstIP
+	if direction == "inbound" {
+		localIP, peerIP = flow.dstIP, flow.srcIP
+	}
+	names := []string{}
+	var candidate *networkPathStep
+	for _, group := range groups {
+		names = append(names, *group.Name)
+		for _, r := range group.Rules {
+			rule, err := networkPathNormalizeRule(r)
+			if err != nil || rule.Direction != direction {
+				continue
+			}
+			if rule.Local != nil && !networkPathAddressMatches(rule.Local.Address, rule.Local.CIDRBlock, localIP) {
+				continue
+			}
+			if !networkPathProtocolMatches(rule, flow, rule.PortMin, rule.PortMax, nil, nil) {
+				continue
+			}
+			step := networkPathStep{stage: stage, resourceType: "security_group", resourceID: *group.ID, resourceName: *group.Name, ruleID: rule.ID, ruleName: rule.Name}
+			switch {
+			case rule.Remote == nil:
+				step.result, step.detail = isNetworkPathResultAllowed, fmt.Sprintf("rule %s of security group %s allows %s traffic from any remote", rule.ID, *group.Name, direction)
+				return step
+			case rule.Remote.ID != "":
+				if peer.securityGroups == nil {
+					if candidate == nil {
+						step.result, step.detail = isNetworkPathResultUnknown, fmt.Sprintf("rule %s of security group %s allows members of security group %s, the membership of %s is unknown", rule.ID, *group.Name, rule.Remote.Name, peerIP)
+						candidate = &step
+					}
+					continue
+				}
+				for _, sg := range peer.securityGroups {
+					if *sg.ID == rule.Remote.ID {
+						step.result, step.detail = isNetworkPathResultAllowed, fmt.Sprintf("rule %s of security group %s allows members of security group %s", rule.ID, *group.Name, rule.Remote.Name)
+						return step
+					}
+				}
+			case networkPathAddressMatches(rule.Remote.Address, rule.Remote.CIDRBlock, peerIP):
+				step.result, step.detail = isNetworkPathResultAllowed, fmt.Sprintf("rule %s of security group %s allows %s", rule.ID, *group.Name, peerIP)
+				return step
+			}
+		}
+	}
+	if candidate != nil {
+		return *candidate
+	}
+	return networkPathStep{
+		stage:        stage,
+		resourceType: "security_group",
+		resourceName: strings.Join(names, ","),
+		result:       isNetworkPathResultDenied,
+		detail:       fmt.Sprintf("no %s rule of security groups %s matches the flow", direction, strings.Join(names, ", ")),
+	}
+}
+
+// networkPathEvaluateNetworkACL applies the rules of the network ACL in order,
+// the first matching rule decides and unmatched traffic is denied.
+func networkPathEvaluateNetworkACL(stage string, acl *vpcv1.NetworkACL, direction string, flow networkPathFlow) (networkPathStep, error) {
+	step := networkPathStep{stage: stage, resourceType: "network_acl", resourceID: *acl.ID, resourceName: *acl.Name}
+	for _, r := range acl.Rules {
+		rule, err := networkPathNormalizeRule(r)
+		if err != nil {
+			return step, err
+		}
+		if rule.Direction != direction || !networkPathCIDRContains(rule.Source, flow.srcIP) || !networkPathCIDRContains(rule.Destination, flow.dstIP) {
+			continue
+		}
+		if !networkPathProtocolMatches(rule, flow, rule.DestinationPortMin, rule.DestinationPortMax, rule.SourcePortMin, rule.SourcePortMax) {
+			continue
+		}
+		step.ruleID, step.ruleName = rule.ID, rule.Name
+		if networkPathPortUnknown(flow.dstPort, rule.DestinationPortMin, rule.DestinationPortMax) || networkPathPortUnknown(flow.srcPort, rule.SourcePortMin, rule.SourcePortMax) {
+			step.result = isNetworkPathResultUnknown
+			step.detail = fmt.Sprintf("rule %s (%s) restricts ports that are not known for this flow, set source_port to evaluate it", rule.Name, rule.Action)
+			return step, nil
+		}
+		step.result = isNetworkPathResultDenied
+		if rule.Action == vpcv1.NetworkACLRuleItemActionAllowConst {
+			step.result = isNetworkPathResultAllowed
+		}
+		step.detail = fmt.Sprintf("rule %s is the first %s rule matching the flow and has action %s", rule.Name, direction, rule.Action)
+		return step, nil
+	}
+	step.result = isNetworkPathResultDenied
+	step.detail = fmt.Sprintf("no %s rule matches the flow, unmatched traffic is denied", direction)
+	return step, nil
+}
+
+// networkPathEvaluateRoute selects the most specific route of the routing table
+// of the source subnet, falling back to the implicit VPC and internet routing.
+func networkPathEvaluateRoute(context context.Context, sess *vpcv1.VpcV1, source, destination *networkPathEndpoint, flow networkPathFlow) (networkPathStep, error) {
+	subnet := source.subnet
+	step := networkPathStep{stage: "route", resourceType: "routing_table", resourceID: *subnet.RoutingTable.ID, resourceName: *subnet.RoutingTable.Name}
+
+	pager, err := sess.NewVPCRoutingTableRoutesPager(&vpcv1.ListVPCRoutingTableRoutesOptions{
+		VPCID:          subnet.VPC.ID,
+		RoutingTableID: subnet.RoutingTable.ID,
+	})
+	if err != nil {
+		return step, err
+	}
+	routes, err := pager.GetAllWithContext(context)
+	if err != nil {
+		return step, fmt.Errorf("VPCRoutingTableRoutesPager.GetAll() failed: %s", err)
+	}
+	if route := networkPathSelectRoute(routes, *subnet.Zone.Name, flow.dstIP); route != nil {
+		step.ruleID, step.ruleName = *route.ID, *route.Name
+		switch *route.Action {
+		case vpcv1.RouteActionDropConst:
+			step.result = isNetworkPathResultDenied
+			step.detail = fmt.Sprintf("route %s for %s drops the traffic", *route.Name, *route.Destination)
+		case vpcv1.RouteActionDeliverConst:
+			step.result = isNetworkPathResultAllowed
+			step.detail = fmt.Sprintf("route %s for %s delivers the traffic to %s", *route.Name, *route.Destination, networkPathNextHop(route.NextHop))
+		default:
+			step.result = isNetworkPathResultAllowed
+			step.detail = fmt.Sprintf("route %s for %s has action %s and uses the system routes", *route.Name, *route.Destination, *route.Action)
+		}
+		return step, nil
+	}
+
+	if destination.subnet != nil {
+		step.result = isNetworkPathResultAllowed
+		step.detail = fmt.Sprintf("no custom route matches, subnet %s of the VPC is reached through the system route", *destination.subnet.Name)
+		return step, nil
+	}
+	if flow.dstIP.IsPrivate() || networkPathCIDRContains("100.64.0.0/10", flow.dstIP) {
+		step.result = isNetworkPathResultUnknown
+		step.detail = "no custom route matches the private destination, it is reachable only through routes learned from a transit gateway or direct link"
+		return step, nil
+	}
+	switch {
+	case subnet.PublicGateway != nil:
+		step.resourceType, step.resourceID, step.resourceName = "public_gateway", *subnet.PublicGateway.ID, *subnet.PublicGateway.Name
+		step.result = isNetworkPathResultAllowed
+		step.detail = fmt.Sprintf("the internet is reached through public gateway %s", *subnet.PublicGateway.Name)
+	case len(source.floatingIPs) > 0:
+		step.resourceType, step.resourceID, step.resourceName = "floating_ip", source.floatingIPs[0], source.floatingIPs[0]
+		step.result = isNetworkPathResultAllowed
+		step.detail = fmt.Sprintf("the internet is reached through floating IP %s", source.floatingIPs[0])
+	case source.floatingIPs == nil:
+		step.result = isNetworkPathResultUnknown
+		step.detail = "the subnet has no public gateway, the internet is reachable only if the source has a floating IP"
+	default:
+		step.result = isNetworkPathResultDenied
+		step.detail = "the subnet has no public gateway and the source has no floating IP"
+	}
+	return step, nil
+}
+
+// networkPathSelectRoute returns the longest prefix match among the routes of
+// the zone, the lowest priority value wins between equally specific routes.
+func networkPathSelectRoute(routes []vpcv1.Route, zone string, ip net.IP) *vpcv1.Route {
+	var selected *vpcv1.Route
+	selectedLen := -1
+	for i := range routes {
+		route := &routes[i]
+		if route.Destination == nil || route.Action == nil || (route.Zone != nil && route.Zone.Name != nil && *route.Zone.Name != zone) {
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(*route.Destination)
+		if err != nil || !cidr.Contains(ip) {
+			continue
+		}
+		ones, _ := cidr.Mask.Size()
+		if ones > selectedLen || (ones == selectedLen && flex.IntValue(route.Priority) < flex.IntValue(selected.Priority)) {
+			selected, selectedLen = route, ones
+		}
+	}
+	return selected
+}
+
+func networkPathNextHop(nextHop vpcv1.RouteNextHopIntf) string {
+	hop := struct {
+		Address string `json:"address"`
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+	}{}
+	if raw, err := json.Marshal(nextHop); err == nil {
+		json.Unmarshal(raw, &hop)
+	}
+	switch {
+	case hop.Address != "":
+		return hop.Address
+	case hop.Name != "":
+		return fmt.Sprintf("VPN gateway connection %s", hop.Name)
+	}
+	return "the next hop"
+}
+
+// networkPathVerdict denies the flow when any stage denies it and reports the
+// first stage that decided the verdict.
+func networkPathVerdict(steps []networkPathStep) (string, string) {
+	var unknown *networkPathStep
+	for i := range steps {
+		switch steps[i].result {
+		case isNetworkPathResultDenied:
+			return isNetworkPathResultDenied, fmt.Sprintf("%s: %s", steps[i].stage, steps[i].detail)
+		case isNetworkPathResultUnknown:
+			if unknown == nil {
+				unknown = &steps[i]
+			}
+		}
+	}
+	if unknown != nil {
+		return isNetworkPathResultUnknown, fmt.Sprintf("%s: %s", unknown.stage, unknown.detail)
+	}
+	return isNetworkPathResultAllowed, "every stage allows the flow"
+}
+
+func networkPathNormalizeRule(rule interface{}) (networkPathRule, error) {
+	normalized := networkPathRule{}
+	raw, err := json.Marshal(rule)
+	if err != nil {
+		return normalized, err
+	}
+	err = json.Unmarshal(raw, &normalized)
+	return normalized, err
+}
+
+func networkPathProtocolMatches(rule networkPathRule, flow networkPathFlow, dstMin, dstMax, srcMin, srcMax *int64) bool {
+	switch rule.Protocol {
+	case "all", "any":
+		return true
+	case "icmp_tcp_udp":
+		return flow.protocol == "icmp" || flow.protocol == "tcp" || flow.protocol == "udp"
+	case "icmp":
+		if flow.protocol != "icmp" {
+			return false
+		}
+		if rule.Type != nil && (flow.icmpType == nil || *rule.Type != *flow.icmpType) {
+			return false
+		}
+		return rule.Code == nil || (flow.icmpCode != nil && *rule.Code == *flow.icmpCode)
+	case "tcp", "udp":
+		return rule.Protocol == flow.protocol && networkPathPortMatches(flow.dstPort, dstMin, dstMax) && networkPathPortMatches(flow.srcPort, srcMin, srcMax)
+	}
+	return rule.Protocol == flow.protocol
+}
+
+// networkPathPortMatches treats an unknown port as matching, the caller reports
+// the match as indeterminate through networkPathPortUnknown.
+func networkPathPortMatches(port int64, min, max *int64) bool {
+	if port == 0 {
+		return true
+	}
+	return (min == nil || port >= *min) && (max == nil || port <= *max)
+}
+
+func networkPathPortUnknown(port int64, min, max *int64) bool {
+	if port != 0 {
+		return false
+	}
+	return (min != nil && *min > 1) || (max != nil && *max < 65535)
+}
+
+func networkPathAddressMatches(address, cidr string, ip net.IP) bool {
+	if address != "" {
+		return net.ParseIP(address).Equal(ip)
+	}
+	if cidr != "" {
+		return networkPathCIDRContains(cidr, ip)
+	}
+	return true
+}
+
+func networkPathCIDRContains(cidr string, ip net.IP) bool {
+	_, network, err := net.ParseCIDR(cidr)
+	return err == nil && network.Contains(ip)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsNetworkPathAnalysisDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	instancename := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigBasic(vpcname, subnetname, sshname, publicKey, instancename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_network_path_analysis.example", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_network_path_analysis.example", "source_ip"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_network_path_analysis.example", "destination_ip"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "verdict", "allowed"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "steps.0.stage", "security_group_egress"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "steps.1.result", "skipped"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsNetworkPathAnalysisDataSourceInternet(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigInternet(vpcname, subnetname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.example", "verdict", "indeterminate"),
+					resource.TestMatchResourceAttr("data.ibm_is_network_path_analysis.example", "reason", regexp.MustCompile("^route: ")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsNetworkPathAnalysisDataSourceMissingPort(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigMissingPort(vpcname, subnetname),
+				ExpectError: regexp.MustCompile("destination_port is required"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigBasic(vpcname, subnetname, sshname, publicKey, instancename string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		count   = 2
+		name    = "%s-${count.index}"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+
+	data "ibm_is_network_path_analysis" "example" {
+		source_instance      = ibm_is_instance.testacc_instance[0].id
+		destination_instance = ibm_is_instance.testacc_instance[1].id
+		protocol             = "tcp"
+		destination_port     = 22
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, instancename, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName)
+}
+
+func testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigInternet(vpcname, subnetname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	data "ibm_is_network_path_analysis" "example" {
+		source_subnet    = ibm_is_subnet.testacc_subnet.id
+		source_ip        = cidrhost(ibm_is_subnet.testacc_subnet.ipv4_cidr_block, 4)
+		destination_ip   = "8.8.8.8"
+		protocol         = "tcp"
+		source_port      = 40000
+		destination_port = 443
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR)
+}
+
+func testAccCheckIBMIsNetworkPathAnalysisDataSourceConfigMissingPort(vpcname, subnetname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	data "ibm_is_network_path_analysis" "example" {
+		source_subnet  = ibm_is_subnet.testacc_subnet.id
+		source_ip      = cidrhost(ibm_is_subnet.testacc_subnet.ipv4_cidr_block, 4)
+		destination_ip = "8.8.8.8"
+		protocol       = "udp"
+	}`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR)
+}
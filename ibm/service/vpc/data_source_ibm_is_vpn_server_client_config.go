@@ -13,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -33,11 +36,87 @@ func DataSourceIBMIsVPNServerClientConfiguration() *schema.Resource {
 				ForceNew:    true,
 				Description: "The File Path to store configuration.",
 			},
+			"client_certificate_crn": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"client_certificate"},
+				Description:   "The CRN of the Secrets Manager certificate secret holding the client certificate and private key to embed in the client profile.",
+			},
+			"client_certificate": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"client_private_key"},
+				ConflictsWith: []string{"client_certificate_crn"},
+				Description:   "The PEM encoded client certificate to embed in the client profile.",
+			},
+			"client_private_key": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "The PEM encoded private key of the client certificate to embed in the client profile.",
+			},
+			"user": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The users to render a client profile for.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the user.",
+						},
+						"certificate_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the Secrets Manager certificate secret of the user. Conflicts with certificate and private_key.",
+						},
+						"certificate": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PEM encoded certificate of the user.",
+						},
+						"private_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The PEM encoded private key of the certificate of the user.",
+						},
+					},
+				},
+			},
 			"vpn_server_client_configuration": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The VPN client configuration.",
 			},
+			"client_profile": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The complete OpenVPN client profile, with the client certificate and key embedded when they are given. The private key is stored in plain text in the state.",
+			},
+			"user_profiles": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OpenVPN client profiles of the users. Embedded private keys are stored in plain text in the state.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the user.",
+						},
+						"profile": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The OpenVPN client profile of the user.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,11 +143,45 @@ func dataSourceIBMIsVPNServerClientConfigurationRead(context context.Context, d
 	configStr = strings.Trim(configStr, `"`)
 	configStr = strings.Replace(configStr, `\n`, "\n", -1)
 
+	certificate, privateKey := d.Get("client_certificate").(string), d.Get("client_private_key").(string)
+	if crn, ok := d.GetOk("client_certificate_crn"); ok {
+		certificate, privateKey, err = getVPNClientCertificateFromSecretsManager(context, meta, crn.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching the client certificate %s: %s", crn.(string), err))
+		}
+	}
+	clientProfile := renderVPNClientProfile(configStr, "", certificate, privateKey)
+
+	userProfiles := make([]map[string]interface{}, 0)
+	for _, u := range d.Get("user").([]interface{}) {
+		user := u.(map[string]interface{})
+		name := user["name"].(string)
+		userCertificate, userPrivateKey := user["certificate"].(string), user["private_key"].(string)
+		if crn := user["certificate_crn"].(string); crn != "" {
+			if userCertificate != "" || userPrivateKey != "" {
+				return diag.FromErr(fmt.Errorf("[ERROR] user %s must set either certificate_crn or certificate and private_key, not both", name))
+			}
+			userCertificate, userPrivateKey, err = getVPNClientCertificateFromSecretsManager(context, meta, crn)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching the certificate %s of user %s: %s", crn, name, err))
+			}
+		}
+		if (userCertificate == "") != (userPrivateKey == "") {
+			return diag.FromErr(fmt.Errorf("[ERROR] user %s must set both certificate and private_key, or neither", name))
+		}
+		userProfiles = append(userProfiles, map[string]interface{}{
+			"name":    name,
+			"profile": renderVPNClientProfile(configStr, name, userCertificate, userPrivateKey),
+		})
+	}
+
 	if v, ok := d.GetOk("file_path"); ok {
 		fileName := v.(string)
-		f, err := os.Create(fileName)
+		// The profile can embed the client private key, so it is only readable by the owner
+		err := os.WriteFile(fileName, []byte(clientProfile), 0600)
 		if err == nil {
-			_, err = f.WriteString(configStr)
+			// WriteFile keeps the mode of an existing file
+			err = os.Chmod(fileName, 0600)
 		}
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error Saving VPNServerClientConfiguration Result: %s", err))
@@ -80,5 +193,74 @@ func dataSourceIBMIsVPNServerClientConfigurationRead(context context.Context, d
 	if err = d.Set("vpn_server_client_configuration", *result); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting VPNServerClientConfiguration Result: %s", err))
 	}
+	if err = d.Set("client_profile", clientProfile); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting client_profile: %s", err))
+	}
+	if err = d.Set("user_profiles", userProfiles); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting user_profiles: %s", err))
+	}
 	return nil
 }
+
+// renderVPNClientProfile replaces the cert and key file references of the
+// OpenVPN configuration with inline blocks, a user name is recorded in a
+// comment at the top of the profile.
+func renderVPNClientProfile(config, user, certificate, privateKey string) string {
+	var profile strings.Builder
+	if user != "" {
+		profile.WriteString(fmt.Sprintf("# OpenVPN client profile for %s\n", user))
+	}
+	for _, line := range strings.Split(config, "\n") {
+		directive := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#;"))
+		if certificate != "" && (strings.HasPrefix(directive, "cert ") || strings.HasPrefix(directive, "key ")) {
+			continue
+		}
+		profile.WriteString(line)
+		profile.WriteString("\n")
+	}
+	if certificate != "" {
+		profile.WriteString("<cert>\n")
+		profile.WriteString(strings.TrimSpace(certificate))
+		profile.WriteString("\n</cert>\n<key>\n")
+		profile.WriteString(strings.TrimSpace(privateKey))
+		profile.WriteString("\n</key>\n")
+	}
+	return profile.String()
+}
+
+// getVPNClientCertificateFromSecretsManager reads the certificate and private
+// key of an imported, public or private certificate secret. The Secrets Manager
+// instance and region are taken from the secret CRN, for example
+// crn:v1:bluemix:public:secrets-manager:us-south:a/123456:<instance ID>:secret:<secret ID>
+func getVPNClientCertificateFromSecretsManager(context context.Context, meta interface{}, crn string) (string, string, error) {
+	parts := strings.Split(crn, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[4] != "secrets-manager" || parts[8] != "secret" {
+		return "", "", fmt.Errorf("%q is not the CRN of a Secrets Manager secret", crn)
+	}
+	region, instanceID, secretID := parts[5], parts[7], parts[9]
+
+	client, err := secretsmanager.GetClientForInstance(meta.(conns.ClientSession), instanceID, region)
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, response, err := client.GetSecretWithContext(context, &secretsmanagerv2.GetSecretOptions{ID: &secretID})
+	if err != nil {
+		return "", "", fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response)
+	}
+	var certificate, privateKey *string
+	switch s := secret.(type) {
+	case *secretsmanagerv2.ImportedCertificate:
+		certificate, privateKey = s.Certificate, s.PrivateKey
+	case *secretsmanagerv2.PrivateCertificate:
+		certificate, privateKey = s.Certificate, s.PrivateKey
+	case *secretsmanagerv2.PublicCertificate:
+		certificate, privateKey = s.Certificate, s.PrivateKey
+	default:
+		return "", "", fmt.Errorf("secret %s is not a certificate", secretID)
+	}
+	if certificate == nil || *certificate == "" || privateKey == nil || *privateKey == "" {
+		return "", "", fmt.Errorf("certificate secret %s has no certificate or private key", secretID)
+	}
+	return *certificate, *privateKey, nil
+}
@@ -52,3 +52,54 @@ func testAccCheckIBMIsVPNServerClientConfigDataSourceConfigBasic(nameVpc, nameSu
 		}
 	`)
 }
+
+func TestAccIBMIsVPNServerClientConfigDataSourceUsers(t *testing.T) {
+	if acc.ISCertificateCrn == "" {
+		fmt.Println("[ERROR] Set the environment variable IS_CERTIFICATE_CRN for testing ibm_is_vpn_server resource")
+	}
+
+	if acc.ISClientCaCrn == "" {
+		fmt.Println("[ERROR] Set the environment variable IS_CLIENT_CA_CRN for testing ibm_is_vpn_server resource")
+	}
+	isCertificateCrn := acc.ISCertificateCrn
+	isClientCaCrn := acc.ISClientCaCrn
+	clientIPPool := "10.5.0.0/21"
+	clientIdleTimeout := fmt.Sprintf("%d", acctest.RandIntRange(0, 28800))
+	enableSplitTunneling := "true"
+	nameVpc := fmt.Sprintf("test-vpc-tf-%d", acctest.RandIntRange(10, 100))
+	nameSubnet1 := fmt.Sprintf("test-subnet1-tf-%d", acctest.RandIntRange(10, 100))
+	vpnServerName := fmt.Sprintf("tfname%d", acctest.RandIntRange(10, 100))
+	port := fmt.Sprintf("%d", acctest.RandIntRange(1, 65535))
+	protocol := "udp"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIsVPNServerClientConfigDataSourceConfigUsers(nameVpc, nameSubnet1, clientIPPool, clientIdleTimeout, enableSplitTunneling, vpnServerName, port, protocol, isCertificateCrn, isClientCaCrn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_server_client_configuration.is_vpn_server_client_configuration", "client_profile"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_server_client_configuration.is_vpn_server_client_configuration", "user_profiles.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_server_client_configuration.is_vpn_server_client_configuration", "user_profiles.0.name", "alice"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_server_client_configuration.is_vpn_server_client_configuration", "user_profiles.1.profile"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNServerClientConfigDataSourceConfigUsers(nameVpc, nameSubnet1, clientIPPool, clientIdleTimeout, enableSplitTunneling, vpnServerName, port, protocol, isCertificateCrn, isClientCaCrn string) string {
+	return testAccCheckIBMIsVPNServerConfigBasic(nameVpc, nameSubnet1, clientIPPool, clientIdleTimeout, enableSplitTunneling, vpnServerName, port, protocol, isCertificateCrn, isClientCaCrn) + fmt.Sprintf(`
+		data "ibm_is_vpn_server_client_configuration" "is_vpn_server_client_configuration" {
+			vpn_server             = ibm_is_vpn_server.is_vpn_server.id
+			client_certificate_crn = "%s"
+			user {
+				name            = "alice"
+				certificate_crn = "%s"
+			}
+			user {
+				name = "bob"
+			}
+		}
+	`, isCertificateCrn, isCertificateCrn)
+}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -88,6 +89,9 @@ const (
 	isBareMetalServerMetadataService                     = "metadata_service"
 	isBareMetalServerMetadataServiceEnabled              = "enabled"
 	isBareMetalServerMetadataServiceProtocol             = "protocol"
+	isBareMetalServerApplyFirmwareUpdate                 = "apply_firmware_update"
+	isBareMetalServerMaintenanceWindow                   = "maintenance_window"
+	isBareMetalServerDisruptiveChanges                   = "disruptive_changes"
 )
 
 func ResourceIBMIsBareMetalServer() *schema.Resource {
@@ -122,6 +126,7 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				},
 			),
 			validateBareMetalServerNicNames,
+			resourceIBMIsBareMetalServerDisruptiveChangesCustomizeDiff(ResourceIBMIsBareMetalServer),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -253,6 +258,53 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 				Computed:    true,
 				Description: "The type of firmware update available",
 			},
+			isBareMetalServerApplyFirmwareUpdate: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether an available firmware update is applied in place. The server is stopped, updated and started again, and the update waits for the server to be running.",
+			},
+			isBareMetalServerMaintenanceWindow: {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The hours disruptive updates, which stop or replace the bare metal server, are allowed in. Updates with disruptive changes fail outside the window, replacements fail at plan time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_zone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateTimeZone,
+							Description:  "The IANA time zone of the window, for example Europe/Berlin.",
+						},
+						"days": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "maintenance_window_day")},
+							Description: "The days of the week the window opens on, one or more of mon, tue, wed, thu, fri, sat and sun.",
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "maintenance_window_time"),
+							Description:  "The local time in HH:MM format the window opens at.",
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server", "maintenance_window_time"),
+							Description:  "The local time in HH:MM format the window closes at. An end_time before start_time closes the window on the next day.",
+						},
+					},
+				},
+			},
+			isBareMetalServerDisruptiveChanges: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Unknown in a plan with changes that stop, reload or replace the bare metal server, and empty once applied. The changes are logged at plan time and reported as a warning when applied.",
+			},
 			isBareMetalServerDisks: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -1475,6 +1527,20 @@ func ResourceIBMIsBareMetalServerValidator() *validate.ResourceValidator {
 			Regexp:                     `^([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-]):([A-Za-z0-9_.-]|[A-Za-z0-9_.-][A-Za-z0-9_ .-]*[A-Za-z0-9_.-])$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "maintenance_window_day",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "mon, tue, wed, thu, fri, sat, sun"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "maintenance_window_time",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([01][0-9]|2[0-3]):[0-5][0-9]$`})
 	ibmISBareMetalServerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_bare_metal_server", Schema: validateSchema}
 	return &ibmISBareMetalServerResourceValidator
 }
@@ -2313,6 +2379,11 @@ func bareMetalServerGet(context context.Context, d *schema.ResourceData, meta in
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_bare_metal_server", "read", "set-firmware_update_type_available").GetDiag()
 		}
 	}
+	// The disruptive changes are unknown in the plan, once applied none are left.
+	if err = d.Set(isBareMetalServerDisruptiveChanges, []string{}); err != nil {
+		err = fmt.Errorf("Error setting disruptive_changes: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_bare_metal_server", "read", "set-disruptive_changes").GetDiag()
+	}
 
 	//enable secure boot
 	if err = d.Set(isBareMetalServerEnableSecureBoot, bms.EnableSecureBoot); err != nil {
@@ -2863,12 +2934,21 @@ func resourceIBMISBareMetalServerUpdate(context context.Context, d *schema.Resou
 
 	id := d.Id()
 
+	disruptiveChanges := bareMetalServerDisruptiveChanges(d)
 	err := bareMetalServerUpdate(context, d, meta, id)
 	if err != nil {
 		return err
 	}
 
-	return resourceIBMISBareMetalServerRead(context, d, meta)
+	diags := resourceIBMISBareMetalServerRead(context, d, meta)
+	if len(disruptiveChanges) > 0 && !diags.HasError() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Bare metal server (%s) was updated with disruptive changes", id),
+			Detail:   fmt.Sprintf("The update stopped or reloaded the bare metal server for the changes of %s.", strings.Join(disruptiveChanges, ", ")),
+		})
+	}
+	return diags
 }
 
 func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
@@ -2878,6 +2958,13 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	if disruptiveChanges := bareMetalServerDisruptiveChanges(d); len(disruptiveChanges) > 0 {
+		err = bareMetalServerCheckMaintenanceWindow(d.Get(isBareMetalServerMaintenanceWindow).([]interface{}), time.Now())
+		if err != nil {
+			err = fmt.Errorf("%s, refusing the disruptive changes %s", err, strings.Join(disruptiveChanges, ", "))
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_bare_metal_server", "update", "maintenance-window").GetDiag()
+		}
+	}
 	if d.HasChange("image") || d.HasChange("keys") || d.HasChange("user_data") || d.HasChange("default_trusted_profile") {
 		stopServerIfStartingForInitialization := false
 		newImageId := d.Get("image").(string)
@@ -4428,6 +4515,37 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if bareMetalServerFirmwareUpdatePending(d) {
+		isServerStopped, err = resourceStopServerIfRunning(id, "hard", d, context, sess, isServerStopped)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("resourceStopServerIfRunning failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		_, err = isWaitForBareMetalServerActionStop(sess, d.Timeout(schema.TimeoutUpdate), id, d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerActionStop failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		updateFirmwareOptions := &vpcv1.UpdateFirmwareForBareMetalServerOptions{
+			ID:        &id,
+			AutoStart: core.BoolPtr(false),
+		}
+		response, err := sess.UpdateFirmwareForBareMetalServerWithContext(context, updateFirmwareOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateFirmwareForBareMetalServerWithContext failed: %s\n%s", err.Error(), response), "ibm_is_bare_metal_server", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		_, err = isWaitForBareMetalServerFirmwareUpdated(sess, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForBareMetalServerFirmwareUpdated failed: %s", err.Error()), "ibm_is_bare_metal_server", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
 	if flag || isServerStopped {
 		_, err = resourceStartServerIfStopped(id, "hard", d, context, sess, isServerStopped)
 		if err != nil {
@@ -5751,3 +5869,255 @@ func validateBareMetalServerNicNames(ctx context.Context, d *schema.ResourceDiff
 
 	return nil
 }
+
+// bareMetalServerChanges is implemented by both schema.ResourceData and
+// schema.ResourceDiff, so disruptive changes are found the same way at plan
+// and at apply time.
+type bareMetalServerChanges interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// bareMetalServerDisruptiveChanges lists the changes of an update that stop
+// or reload the bare metal server.
+func bareMetalServerDisruptiveChanges(d bareMetalServerChanges) []string {
+	changes := []string{}
+	for _, key := range []string{isBareMetalServerImage, isBareMetalServerKeys, isBareMetalServerUserData, isBareMetalServerDefaultTrustedProfile} {
+		if d.HasChange(key) {
+			changes = append(changes, fmt.Sprintf("%s (reload)", key))
+		}
+	}
+	for _, key := range []string{isBareMetalServerEnableSecureBoot, isBareMetalServerMetadataService, "trusted_platform_module.0.mode"} {
+		if d.HasChange(key) {
+			changes = append(changes, fmt.Sprintf("%s (stop and start)", key))
+		}
+	}
+	for _, key := range []string{isBareMetalServerNetworkInterfaces, "network_attachments"} {
+		if d.HasChange(key) {
+			oldList, newList := d.GetChange(key)
+			if bareMetalServerPCIInterfacesChanged(key, oldList.([]interface{}), newList.([]interface{})) {
+				changes = append(changes, fmt.Sprintf("%s (stop and start)", key))
+			}
+		}
+	}
+	if bareMetalServerFirmwareUpdatePending(d) {
+		changes = append(changes, "firmware (stop, update and start)")
+	}
+	return changes
+}
+
+// bareMetalServerPCIInterfacesChanged reports whether a change of
+// network_interfaces or network_attachments adds or removes a PCI interface.
+// The server is stopped for those, VLAN and hipersocket interfaces are added
+// and removed while it runs. Network interfaces are matched by name and
+// network attachments by ID, the same way the update does.
+func bareMetalServerPCIInterfacesChanged(key string, oldList, newList []interface{}) bool {
+	match := "id"
+	if key == isBareMetalServerNetworkInterfaces {
+		match = isBareMetalServerNicName
+	}
+	items := func(list []interface{}) map[string]map[string]interface{} {
+		result := map[string]map[string]interface{}{}
+		for i, item := range list {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := m[match].(string)
+			if id == "" {
+				id = fmt.Sprintf("new %d", i)
+			}
+			result[id] = m
+		}
+		return result
+	}
+	oldItems, newItems := items(oldList), items(newList)
+	for id, item := range oldItems {
+		if _, ok := newItems[id]; !ok && item[isBareMetalServerNicInterfaceType] == "pci" {
+			return true
+		}
+	}
+	for id, item := range newItems {
+		if _, ok := oldItems[id]; ok {
+			continue
+		}
+		if item[isBareMetalServerNicInterfaceType] == "pci" {
+			return true
+		}
+		if key == isBareMetalServerNetworkInterfaces {
+			if vlans, ok := item[isBareMetalServerNicAllowedVlans].(*schema.Set); ok && vlans.Len() > 0 {
+				return true
+			}
+		} else if vlan, _ := item[isBareMetalServerNicVlan].(int); vlan == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// bareMetalServerReplacingChanges lists the changed arguments that force a
+// new bare metal server.
+func bareMetalServerReplacingChanges(diff *schema.ResourceDiff, schemaMap map[string]*schema.Schema, prefix string) []string {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	changes := []string{}
+	for _, key := range keys {
+		attr, path := schemaMap[key], prefix+key
+		if attr.ForceNew {
+			if diff.HasChange(path) {
+				changes = append(changes, path)
+			}
+			continue
+		}
+		elem, ok := attr.Elem.(*schema.Resource)
+		if !ok || attr.Type != schema.TypeList || !diff.HasChange(path) {
+			continue
+		}
+		oldList, newList := diff.GetChange(path)
+		for i := 0; i < max(len(oldList.([]interface{})), len(newList.([]interface{}))); i++ {
+			changes = append(changes, bareMetalServerReplacingChanges(diff, elem.Schema, fmt.Sprintf("%s.%d.", path, i))...)
+		}
+	}
+	return changes
+}
+
+func bareMetalServerFirmwareUpdatePending(d bareMetalServerChanges) bool {
+	if !d.Get(isBareMetalServerApplyFirmwareUpdate).(bool) {
+		return false
+	}
+	available, _ := d.GetChange(isBareMetalServerFirmwareUpdateTypeAvailable)
+	return available.(string) == vpcv1.BareMetalServerFirmwareUpdateOptionalConst || available.(string) == vpcv1.BareMetalServerFirmwareUpdateRequiredConst
+}
+
+// resourceIBMIsBareMetalServerDisruptiveChangesCustomizeDiff marks
+// disruptive_changes as unknown when the plan has disruptive changes, which
+// are logged. The attribute is empty once the plan is applied, a value set at
+// plan time would not match. A pending firmware update marks
+// firmware_update_type_available as changing so the update runs.
+func resourceIBMIsBareMetalServerDisruptiveChangesCustomizeDiff(resource func() *schema.Resource) schema.CustomizeDiffFunc {
+	schemaMap := sync.OnceValue(func() map[string]*schema.Schema {
+		return resource().Schema
+	})
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		changes := bareMetalServerDisruptiveChanges(diff)
+		if bareMetalServerFirmwareUpdatePending(diff) {
+			if err := diff.SetNewComputed(isBareMetalServerFirmwareUpdateTypeAvailable); err != nil {
+				return err
+			}
+		}
+		replacing := bareMetalServerReplacingChanges(diff, schemaMap(), "")
+		if len(replacing) > 0 {
+			// A replacement deletes the server before apply reaches the update, so
+			// the maintenance window is checked at plan time.
+			if err := bareMetalServerCheckMaintenanceWindow(diff.Get(isBareMetalServerMaintenanceWindow).([]interface{}), time.Now()); err != nil {
+				return fmt.Errorf("%s, refusing to replace the bare metal server for the changes of %s", err, strings.Join(replacing, ", "))
+			}
+			for _, key := range replacing {
+				changes = append(changes, fmt.Sprintf("%s (replacement)", key))
+			}
+		}
+		if len(changes) > 0 {
+			log.Printf("[WARN] Bare metal server (%s) update is disruptive: %s", diff.Id(), strings.Join(changes, ", "))
+			return diff.SetNewComputed(isBareMetalServerDisruptiveChanges)
+		}
+		return nil
+	}
+}
+
+// bareMetalServerCheckMaintenanceWindow returns an error when a maintenance
+// window is configured and now is outside of it.
+func bareMetalServerCheckMaintenanceWindow(windows []interface{}, now time.Time) error {
+	if len(windows) == 0 || windows[0] == nil {
+		return nil
+	}
+	window := windows[0].(map[string]interface{})
+	location, err := time.LoadLocation(window["time_zone"].(string))
+	if err != nil {
+		return err
+	}
+	local := now.In(location)
+	clock := local.Format("15:04")
+	startTime, endTime := window["start_time"].(string), window["end_time"].(string)
+	days := map[string]bool{}
+	for _, day := range window["days"].([]interface{}) {
+		days[day.(string)] = true
+	}
+	today := strings.ToLower(local.Weekday().String()[:3])
+	yesterday := strings.ToLower(local.AddDate(0, 0, -1).Weekday().String()[:3])
+
+	open := false
+	if startTime < endTime {
+		open = days[today] && clock >= startTime && clock < endTime
+	} else {
+		// The window spans midnight and closes on the day after it opened.
+		open = (days[today] && clock >= startTime) || (days[yesterday] && clock < endTime)
+	}
+	if !open {
+		return fmt.Errorf("the current time %s %s is outside the maintenance window %s-%s on %s", local.Format("Mon 15:04"), location, startTime, endTime, strings.Join(flex.ExpandStringList(window["days"].([]interface{})), ", "))
+	}
+	return nil
+}
+
+// isWaitForBareMetalServerFirmwareUpdated waits for the firmware update of a
+// stopped bare metal server. The server is still stopped when the update is
+// requested, so the wait first waits for the update to start, or for the
+// firmware to be up to date, and then for the server to leave maintenance.
+func isWaitForBareMetalServerFirmwareUpdated(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Bare Metal Server (%s) firmware update to start.", id)
+	const firmwareUpdated = "firmware_updated"
+	refresh := isBareMetalServerFirmwareUpdateRefreshFunc(client, id)
+	start := time.Now()
+	startConf := &resource.StateChangeConf{
+		Pending: []string{isBareMetalServerActionStatusStopped, isBareMetalServerActionStatusStopping},
+		Target:  []string{isBareMetalServerStatusPending, "maintenance", isBareMetalServerActionStatusStarting, isBareMetalServerStatusRestarting, isBareMetalServerStatusRunning, firmwareUpdated},
+		Refresh: func() (interface{}, string, error) {
+			bms, status, err := refresh()
+			if err != nil {
+				return bms, status, err
+			}
+			if server := bms.(*vpcv1.BareMetalServer); server.Firmware != nil && server.Firmware.Update != nil && *server.Firmware.Update == vpcv1.BareMetalServerFirmwareUpdateNoneConst {
+				return bms, firmwareUpdated, nil
+			}
+			return bms, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := startConf.WaitForState(); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Waiting for Bare Metal Server (%s) firmware update to complete.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusPending, "maintenance", isBareMetalServerActionStatusStopping, isBareMetalServerActionStatusStarting, isBareMetalServerStatusRestarting},
+		Target:     []string{isBareMetalServerActionStatusStopped, isBareMetalServerStatusRunning},
+		Refresh:    refresh,
+		Timeout:    timeout - time.Since(start),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func isBareMetalServerFirmwareUpdateRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		bms, response, err := client.GetBareMetalServer(&vpcv1.GetBareMetalServerOptions{
+			ID: &id,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error getting Bare Metal Server: %s\n%s", err, response)
+		}
+		if *bms.Status == isBareMetalServerStatusFailed {
+			return bms, *bms.Status, fmt.Errorf("[ERROR] Bare Metal Server (%s) went into failed state during the firmware update", id)
+		}
+		return bms, *bms.Status, nil
+	}
+}
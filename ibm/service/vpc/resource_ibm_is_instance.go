@@ -1646,10 +1646,12 @@ func ResourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstanceUserData: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "User data given for the instance",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				ValidateFunc:     validateIsInstanceUserDataSize,
+				DiffSuppressFunc: suppressUserDataEncodingDiff,
+				Description:      "User data given for the instance",
 			},
 
 			isInstanceImage: {
@@ -7,13 +7,21 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	// Embeds the IANA time zone database, so schedule time zones resolve on
+	// hosts without one
+	_ "time/tzdata"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,6 +39,10 @@ func ResourceIBMISInstanceGroupManager() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			resourceIBMISInstanceGroupManagerScheduleCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			"name": {
@@ -106,6 +118,111 @@ func ResourceIBMISInstanceGroupManager() *schema.Resource {
 				Description: "list of Policies associated with instancegroup manager",
 			},
 
+			"schedule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Time zone aware calendar rules of a scheduled instance group manager. Every rule is expanded into managed scheduled actions that scale the target autoscale manager.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "schedule_name"),
+							Description:  "The name of the rule. The managed actions are named after the rule with a -start and -end suffix.",
+						},
+						"time_zone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateTimeZone,
+							Description:  "The IANA time zone of start_time and end_time, for example Europe/Berlin.",
+						},
+						"days": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "schedule_day")},
+							Description: "The days of the week the rule applies to, one or more of mon, tue, wed, thu, fri, sat and sun.",
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "schedule_time"),
+							Description:  "The local time in HH:MM format the membership range of the rule is applied at.",
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "schedule_time"),
+							Description:  "The local time in HH:MM format the off hours membership range is applied at. Requires off_hours_min_membership_count and off_hours_max_membership_count.",
+						},
+						"target_manager": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the autoscale instance group manager the rule scales.",
+						},
+						"min_membership_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "min_membership_count"),
+							Description:  "The minimum number of members from start_time.",
+						},
+						"max_membership_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "max_membership_count"),
+							Description:  "The maximum number of members from start_time.",
+						},
+						"off_hours_min_membership_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "min_membership_count"),
+							Description:  "The minimum number of members from end_time.",
+						},
+						"off_hours_max_membership_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group_manager", "max_membership_count"),
+							Description:  "The maximum number of members from end_time.",
+						},
+					},
+				},
+			},
+
+			"schedule_actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The scheduled actions managed for the schedule rules, with their cron specifications in UTC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the action.",
+						},
+						"cron_spec": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cron specification of the action in UTC.",
+						},
+						"target_manager": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the autoscale instance group manager the action scales.",
+						},
+						"min_membership_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The minimum number of members the action applies.",
+						},
+						"max_membership_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum number of members the action applies.",
+						},
+					},
+				},
+			},
+
 			"actions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -178,6 +295,29 @@ func ResourceIBMISInstanceGroupManagerValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "1",
 			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "schedule_name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             57})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "schedule_day",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "mon, tue, wed, thu, fri, sat, sun"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "schedule_time",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([01][0-9]|2[0-3]):[0-5][0-9]$`})
 
 	ibmISInstanceGroupManagerResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group_manager", Schema: validateSchema}
 	return &ibmISInstanceGroupManagerResourceValidator
@@ -222,6 +362,15 @@ func resourceIBMISInstanceGroupManagerCreate(context context.Context, d *schema.
 		instanceGroupManager := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
 		d.SetId(fmt.Sprintf("%s/%s", instanceGroupID, *instanceGroupManager.ID))
 
+		if schedules := d.Get("schedule").([]interface{}); len(schedules) > 0 {
+			err = reconcileInstanceGroupManagerSchedule(context, sess, meta, d, instanceGroupID, *instanceGroupManager.ID, nil)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error applying schedule: %s", err.Error()), "ibm_is_instance_group_manager", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+
 	} else {
 
 		instanceGroupManagerPrototype := vpcv1.InstanceGroupManagerPrototypeInstanceGroupManagerAutoScalePrototype{}
@@ -367,6 +516,20 @@ func resourceIBMISInstanceGroupManagerUpdate(context context.Context, d *schema.
 			return tfErr.GetDiag()
 		}
 	}
+
+	if managerType == "scheduled" && (d.HasChange("schedule") || d.HasChange("schedule_actions")) {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager", "update", "sep-id-parts").GetDiag()
+		}
+		previous, _ := d.GetChange("schedule_actions")
+		err = reconcileInstanceGroupManagerSchedule(context, sess, meta, d, parts[0], parts[1], previous.([]interface{}))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error applying schedule: %s", err.Error()), "ibm_is_instance_group_manager", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISInstanceGroupManagerRead(context, d, meta)
 }
 
@@ -428,6 +591,16 @@ func resourceIBMISInstanceGroupManagerRead(context context.Context, d *schema.Re
 			err = fmt.Errorf("Error setting manager_type: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager", "read", "set-manager_type").GetDiag()
 		}
+		scheduleActions, err := readInstanceGroupManagerScheduleActions(context, sess, instanceGroupID, instanceGroupManagerID, d.Get("schedule").([]interface{}))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error reading schedule actions: %s", err.Error()), "ibm_is_instance_group_manager", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if err = d.Set("schedule_actions", scheduleActions); err != nil {
+			err = fmt.Errorf("Error setting schedule_actions: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager", "read", "set-schedule_actions").GetDiag()
+		}
 	} else {
 		if !core.IsNil(instanceGroupManager.Name) {
 			if err = d.Set("name", instanceGroupManager.Name); err != nil {
@@ -548,3 +721,352 @@ func resourceIBMISInstanceGroupManagerDelete(context context.Context, d *schema.
 	}
 	return nil
 }
+
+var instanceGroupManagerScheduleDays = map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+
+func resourceIBMISInstanceGroupManagerScheduleCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	schedules := diff.Get("schedule").([]interface{})
+	if len(schedules) > 0 && diff.Get("manager_type").(string) != "scheduled" {
+		return fmt.Errorf("schedule can only be set on an instance group manager with manager_type scheduled")
+	}
+	if !diff.NewValueKnown("schedule") {
+		return diff.SetNewComputed("schedule_actions")
+	}
+	desired, err := expandInstanceGroupManagerSchedule(schedules, time.Now())
+	if err != nil {
+		return err
+	}
+	// The cron specifications are in UTC, so a daylight saving time change in
+	// the time zone of a rule, or an action edited outside of terraform, shows
+	// up as a change to schedule_actions.
+	if !reflect.DeepEqual(flattenInstanceGroupManagerScheduleActions(desired), normalizeInstanceGroupManagerScheduleActions(diff.Get("schedule_actions").([]interface{}))) {
+		return diff.SetNew("schedule_actions", flattenInstanceGroupManagerScheduleActions(desired))
+	}
+	return nil
+}
+
+type instanceGroupManagerScheduleAction struct {
+	Name               string
+	CronSpec           string
+	TargetManager      string
+	MinMembershipCount int64
+	MaxMembershipCount int64
+}
+
+// expandInstanceGroupManagerSchedule turns the schedule rules into scheduled
+// actions, the local start and end times are converted to UTC with the offset
+// of the rule's time zone at now. Cron specifications cannot follow a change of
+// the offset, so after a daylight saving time change the actions run an hour
+// off until the configuration is applied again.
+func expandInstanceGroupManagerSchedule(schedules []interface{}, now time.Time) ([]instanceGroupManagerScheduleAction, error) {
+	actions := []instanceGroupManagerScheduleAction{}
+	names := map[string]bool{}
+	for _, s := range schedules {
+		schedule := s.(map[string]interface{})
+		name := schedule["name"].(string)
+		if names[name] {
+			return nil, fmt.Errorf("schedule name %s is used more than once", name)
+		}
+		names[name] = true
+
+		location, err := time.LoadLocation(schedule["time_zone"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %s", name, err)
+		}
+		days := []int{}
+		for _, day := range schedule["days"].([]interface{}) {
+			days = append(days, instanceGroupManagerScheduleDays[day.(string)])
+		}
+		min, max := int64(schedule["min_membership_count"].(int)), int64(schedule["max_membership_count"].(int))
+		if min > max {
+			return nil, fmt.Errorf("schedule %s: min_membership_count %d is greater than max_membership_count %d", name, min, max)
+		}
+		target := schedule["target_manager"].(string)
+
+		cronSpec, err := instanceGroupManagerScheduleCronSpec(schedule["start_time"].(string), days, location, now)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %s", name, err)
+		}
+		actions = append(actions, instanceGroupManagerScheduleAction{
+			Name:               name + "-start",
+			CronSpec:           cronSpec,
+			TargetManager:      target,
+			MinMembershipCount: min,
+			MaxMembershipCount: max,
+		})
+
+		endTime := schedule["end_time"].(string)
+		offMin, offMax := int64(schedule["off_hours_min_membership_count"].(int)), int64(schedule["off_hours_max_membership_count"].(int))
+		if endTime == "" {
+			if offMin != 0 || offMax != 0 {
+				return nil, fmt.Errorf("schedule %s: off_hours_min_membership_count and off_hours_max_membership_count require end_time", name)
+			}
+			continue
+		}
+		if endTime == schedule["start_time"].(string) {
+			return nil, fmt.Errorf("schedule %s: end_time must differ from start_time", name)
+		}
+		if offMin == 0 || offMax == 0 {
+			return nil, fmt.Errorf("schedule %s: end_time requires off_hours_min_membership_count and off_hours_max_membership_count", name)
+		}
+		if offMin > offMax {
+			return nil, fmt.Errorf("schedule %s: off_hours_min_membership_count %d is greater than off_hours_max_membership_count %d", name, offMin, offMax)
+		}
+		// An end time before the start time ends the window on the next day.
+		endDays := days
+		if endTime < schedule["start_time"].(string) {
+			endDays = []int{}
+			for _, day := range days {
+				endDays = append(endDays, (day+1)%7)
+			}
+		}
+		cronSpec, err = instanceGroupManagerScheduleCronSpec(endTime, endDays, location, now)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %s", name, err)
+		}
+		actions = append(actions, instanceGroupManagerScheduleAction{
+			Name:               name + "-end",
+			CronSpec:           cronSpec,
+			TargetManager:      target,
+			MinMembershipCount: offMin,
+			MaxMembershipCount: offMax,
+		})
+	}
+	return actions, nil
+}
+
+// instanceGroupManagerScheduleCronSpec returns the UTC cron specification of a
+// local HH:MM time on the given days of the week, days move with the time when
+// the conversion crosses midnight.
+func instanceGroupManagerScheduleCronSpec(localTime string, days []int, location *time.Location, now time.Time) (string, error) {
+	clock, err := time.Parse("15:04", localTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid time %q, expected HH:MM", localTime)
+	}
+	today := now.In(location)
+	local := time.Date(today.Year(), today.Month(), today.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+	_, offset := local.Zone()
+
+	minutes := clock.Hour()*60 + clock.Minute() - offset/60
+	shift := 0
+	for minutes < 0 {
+		minutes += 24 * 60
+		shift--
+	}
+	for minutes >= 24*60 {
+		minutes -= 24 * 60
+		shift++
+	}
+
+	seen := map[int]bool{}
+	utcDays := []int{}
+	for _, day := range days {
+		utcDay := ((day+shift)%7 + 7) % 7
+		if !seen[utcDay] {
+			seen[utcDay] = true
+			utcDays = append(utcDays, utcDay)
+		}
+	}
+	sort.Ints(utcDays)
+	dayList := make([]string, len(utcDays))
+	for i, day := range utcDays {
+		dayList[i] = strconv.Itoa(day)
+	}
+	return fmt.Sprintf("%d %d * * %s", minutes%60, minutes/60, strings.Join(dayList, ",")), nil
+}
+
+func flattenInstanceGroupManagerScheduleActions(actions []instanceGroupManagerScheduleAction) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		result = append(result, map[string]interface{}{
+			"name":                 action.Name,
+			"cron_spec":            action.CronSpec,
+			"target_manager":       action.TargetManager,
+			"min_membership_count": int(action.MinMembershipCount),
+			"max_membership_count": int(action.MaxMembershipCount),
+		})
+	}
+	return result
+}
+
+func normalizeInstanceGroupManagerScheduleActions(actions []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(actions))
+	for _, a := range actions {
+		action := a.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name":                 action["name"],
+			"cron_spec":            action["cron_spec"],
+			"target_manager":       action["target_manager"],
+			"min_membership_count": action["min_membership_count"],
+			"max_membership_count": action["max_membership_count"],
+		})
+	}
+	return result
+}
+
+func listInstanceGroupManagerActions(context context.Context, sess *vpcv1.VpcV1, instanceGroupID, instanceGroupManagerID string) (map[string]*vpcv1.InstanceGroupManagerAction, error) {
+	pager, err := sess.NewInstanceGroupManagerActionsPager(&vpcv1.ListInstanceGroupManagerActionsOptions{
+		InstanceGroupID:        &instanceGroupID,
+		InstanceGroupManagerID: &instanceGroupManagerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	allItems, err := pager.GetAllWithContext(context)
+	if err != nil {
+		return nil, err
+	}
+	actions := map[string]*vpcv1.InstanceGroupManagerAction{}
+	for _, item := range allItems {
+		if action, ok := item.(*vpcv1.InstanceGroupManagerAction); ok && action.Name != nil {
+			actions[*action.Name] = action
+		}
+	}
+	return actions, nil
+}
+
+// readInstanceGroupManagerScheduleActions returns the actions of the schedule
+// rules as they exist on the manager, actions that are not found are left out.
+func readInstanceGroupManagerScheduleActions(context context.Context, sess *vpcv1.VpcV1, instanceGroupID, instanceGroupManagerID string, schedules []interface{}) ([]interface{}, error) {
+	result := []interface{}{}
+	if len(schedules) == 0 {
+		return result, nil
+	}
+	existing, err := listInstanceGroupManagerActions(context, sess, instanceGroupID, instanceGroupManagerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		name := s.(map[string]interface{})["name"].(string)
+		for _, actionName := range []string{name + "-start", name + "-end"} {
+			action, ok := existing[actionName]
+			if !ok {
+				continue
+			}
+			scheduleAction := map[string]interface{}{
+				"name":                 actionName,
+				"cron_spec":            "",
+				"target_manager":       "",
+				"min_membership_count": 0,
+				"max_membership_count": 0,
+			}
+			if action.CronSpec != nil {
+				scheduleAction["cron_spec"] = *action.CronSpec
+			}
+			if manager, ok := action.Manager.(*vpcv1.InstanceGroupManagerScheduledActionManager); ok && manager != nil {
+				if manager.ID != nil {
+					scheduleAction["target_manager"] = *manager.ID
+				}
+				if manager.MinMembershipCount != nil {
+					scheduleAction["min_membership_count"] = flex.IntValue(manager.MinMembershipCount)
+				}
+				if manager.MaxMembershipCount != nil {
+					scheduleAction["max_membership_count"] = flex.IntValue(manager.MaxMembershipCount)
+				}
+			}
+			result = append(result, scheduleAction)
+		}
+	}
+	return result, nil
+}
+
+// reconcileInstanceGroupManagerSchedule creates, updates and deletes the
+// scheduled actions of the manager so they match the schedule rules. Actions
+// named in previous that no longer belong to a rule are deleted, other actions
+// of the manager are left alone.
+func reconcileInstanceGroupManagerSchedule(context context.Context, sess *vpcv1.VpcV1, meta interface{}, d *schema.ResourceData, instanceGroupID, instanceGroupManagerID string, previous []interface{}) error {
+	desired, err := expandInstanceGroupManagerSchedule(d.Get("schedule").([]interface{}), time.Now())
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if _, err = waitForHealthyInstanceGroup(instanceGroupID, meta, timeout); err != nil {
+		return err
+	}
+	existing, err := listInstanceGroupManagerActions(context, sess, instanceGroupID, instanceGroupManagerID)
+	if err != nil {
+		return fmt.Errorf("ListInstanceGroupManagerActions failed: %s", err)
+	}
+
+	deleteAction := func(action *vpcv1.InstanceGroupManagerAction) error {
+		response, err := sess.DeleteInstanceGroupManagerActionWithContext(context, &vpcv1.DeleteInstanceGroupManagerActionOptions{
+			InstanceGroupID:        &instanceGroupID,
+			InstanceGroupManagerID: &instanceGroupManagerID,
+			ID:                     action.ID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("DeleteInstanceGroupManagerActionWithContext failed for %s: %s\n%s", *action.Name, err, response)
+		}
+		return nil
+	}
+
+	wanted := map[string]bool{}
+	for _, action := range desired {
+		wanted[action.Name] = true
+		current, ok := existing[action.Name]
+		if ok {
+			manager, _ := current.Manager.(*vpcv1.InstanceGroupManagerScheduledActionManager)
+			if manager == nil || manager.ID == nil || *manager.ID != action.TargetManager {
+				// The target manager of an action cannot be changed in place.
+				if err = deleteAction(current); err != nil {
+					return err
+				}
+				ok = false
+			} else if current.CronSpec == nil || *current.CronSpec != action.CronSpec ||
+				manager.MinMembershipCount == nil || *manager.MinMembershipCount != action.MinMembershipCount ||
+				manager.MaxMembershipCount == nil || *manager.MaxMembershipCount != action.MaxMembershipCount {
+				patchModel := &vpcv1.InstanceGroupManagerActionPatch{
+					CronSpec: core.StringPtr(action.CronSpec),
+					Manager: &vpcv1.InstanceGroupManagerActionManagerPatch{
+						MinMembershipCount: core.Int64Ptr(action.MinMembershipCount),
+						MaxMembershipCount: core.Int64Ptr(action.MaxMembershipCount),
+					},
+				}
+				patch, err := patchModel.AsPatch()
+				if err != nil {
+					return fmt.Errorf("Error calling asPatch for InstanceGroupManagerActionPatch: %s", err)
+				}
+				_, response, err := sess.UpdateInstanceGroupManagerActionWithContext(context, &vpcv1.UpdateInstanceGroupManagerActionOptions{
+					InstanceGroupID:                 &instanceGroupID,
+					InstanceGroupManagerID:          &instanceGroupManagerID,
+					ID:                              current.ID,
+					InstanceGroupManagerActionPatch: patch,
+				})
+				if err != nil {
+					return fmt.Errorf("UpdateInstanceGroupManagerActionWithContext failed for %s: %s\n%s", action.Name, err, response)
+				}
+			}
+		}
+		if !ok {
+			_, response, err := sess.CreateInstanceGroupManagerActionWithContext(context, &vpcv1.CreateInstanceGroupManagerActionOptions{
+				InstanceGroupID:        &instanceGroupID,
+				InstanceGroupManagerID: &instanceGroupManagerID,
+				InstanceGroupManagerActionPrototype: &vpcv1.InstanceGroupManagerActionPrototype{
+					Name:     core.StringPtr(action.Name),
+					CronSpec: core.StringPtr(action.CronSpec),
+					Manager: &vpcv1.InstanceGroupManagerScheduledActionManagerPrototype{
+						ID:                 core.StringPtr(action.TargetManager),
+						MinMembershipCount: core.Int64Ptr(action.MinMembershipCount),
+						MaxMembershipCount: core.Int64Ptr(action.MaxMembershipCount),
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("CreateInstanceGroupManagerActionWithContext failed for %s: %s\n%s", action.Name, err, response)
+			}
+		}
+	}
+
+	for _, p := range previous {
+		name := p.(map[string]interface{})["name"].(string)
+		if current, ok := existing[name]; ok && !wanted[name] {
+			if err = deleteAction(current); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
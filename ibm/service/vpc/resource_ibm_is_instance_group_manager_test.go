@@ -75,6 +75,51 @@ func TestAccIBMISInstanceGroupManager_basic_scheduled(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroupManager_scheduleRules(t *testing.T) {
+	randInt := acctest.RandIntRange(200, 300)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	instanceGroupManager := fmt.Sprintf("testinstancegroupmanager%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGroupManagerConfigSchedule(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "08:00", "18:00"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.0.name", "business-hours-start"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.1.name", "business-hours-end"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.0.min_membership_count", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.0.cron_spec"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupManagerConfigSchedule(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, "07:30", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group_manager.instance_group_manager", "schedule_actions.0.name", "business-hours-start"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISInstanceGroupManagerDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -205,3 +250,85 @@ func testAccCheckIBMISInstanceGroupManagerConfigScheduled(vpcName, subnetName, s
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager)
 
 }
+
+func testAccCheckIBMISInstanceGroupManagerConfigSchedule(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, startTime, endTime string) string {
+	offHours := ""
+	if endTime != "" {
+		offHours = fmt.Sprintf(`
+			end_time                       = "%s"
+			off_hours_min_membership_count = 1
+			off_hours_max_membership_count = 1`, endTime)
+	}
+	return fmt.Sprintf(`
+	provider "ibm" {
+		generation = 2
+	}
+	
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+	
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+	
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+	
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	   name    = "%s"
+	   image   = "r006-14140f94-fcc4-11e9-96e7-a72723715315"
+	   profile = "bx2-8x32"
+	
+	   primary_network_interface {
+		 subnet = ibm_is_subnet.subnet2.id
+	   }
+	
+	   vpc       = ibm_is_vpc.vpc2.id
+	   zone      = "us-south-2"
+	   keys      = [ibm_is_ssh_key.sshkey.id]
+	 }
+		
+	resource "ibm_is_instance_group" "instance_group" {
+		name =  "%s"
+		instance_template = ibm_is_instance_template.instancetemplate1.id
+		instance_count =  2
+		subnets = [ibm_is_subnet.subnet2.id]
+	}
+
+	resource "ibm_is_instance_group_manager" "autoscale" {
+		name = "%s-autoscale"
+		aggregation_window = 120
+		instance_group = ibm_is_instance_group.instance_group.id
+		cooldown = 300
+		manager_type = "autoscale"
+		enable_manager = true
+		max_membership_count = 2
+		min_membership_count = 1
+	}
+
+	resource "ibm_is_instance_group_manager" "instance_group_manager" {
+		name = "%s"
+		instance_group = ibm_is_instance_group.instance_group.id
+		manager_type = "scheduled"
+		enable_manager = true
+
+		schedule {
+			name                 = "business-hours"
+			time_zone            = "Europe/Berlin"
+			days                 = ["mon", "tue", "wed", "thu", "fri"]
+			start_time           = "%s"
+			target_manager       = ibm_is_instance_group_manager.autoscale.manager_id
+			min_membership_count = 2
+			max_membership_count = 2%s
+		}
+	}
+
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, instanceGroupManager, instanceGroupManager, startTime, offHours)
+
+}
@@ -1216,10 +1216,12 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 			},
 
 			isInstanceTemplateUserData: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "User data given for the instance",
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				ValidateFunc:     validateIsInstanceUserDataSize,
+				DiffSuppressFunc: suppressUserDataEncodingDiff,
+				Description:      "User data given for the instance",
 			},
 
 			isInstanceTemplateCRN: {
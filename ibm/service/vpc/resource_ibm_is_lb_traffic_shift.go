@@ -0,0 +1,473 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBTrafficShiftLB                = "lb"
+	isLBTrafficShiftListener          = "listener"
+	isLBTrafficShiftBluePool          = "blue_pool"
+	isLBTrafficShiftGreenPool         = "green_pool"
+	isLBTrafficShiftActive            = "active"
+	isLBTrafficShiftMinHealthyMembers = "min_healthy_members"
+	isLBTrafficShiftRollback          = "rollback_on_failure"
+	isLBTrafficShiftObservationPeriod = "observation_period"
+	isLBTrafficShiftActivePoolID      = "active_pool_id"
+	isLBTrafficShiftPreviousPoolID    = "previous_pool_id"
+	isLBTrafficShiftLastShiftAt       = "last_shift_at"
+	isLBTrafficShiftBlue              = "blue"
+	isLBTrafficShiftGreen             = "green"
+	isLBTrafficShiftPoolHealthy       = "healthy"
+	isLBTrafficShiftPoolWaiting       = "waiting"
+)
+
+func ResourceIBMISLBTrafficShift() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBTrafficShiftCreate,
+		ReadContext:   resourceIBMISLBTrafficShiftRead,
+		UpdateContext: resourceIBMISLBTrafficShiftUpdate,
+		DeleteContext: resourceIBMISLBTrafficShiftDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBTrafficShiftLB: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The load balancer identifier.",
+			},
+			isLBTrafficShiftListener: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// accept both the listener id and the <lb>/<listener> id of ibm_is_lb_listener
+					return o != "" && o == lbTrafficShiftLastPart(n)
+				},
+				Description: "The load balancer listener whose default pool is switched.",
+			},
+			isLBTrafficShiftBluePool: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressLBTrafficShiftPoolID,
+				Description:      "The blue load balancer pool.",
+			},
+			isLBTrafficShiftGreenPool: {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressLBTrafficShiftPoolID,
+				Description:      "The green load balancer pool.",
+			},
+			isLBTrafficShiftActive: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_traffic_shift", isLBTrafficShiftActive),
+				Description:  "The pool that should receive the listener traffic, either `blue` or `green`.",
+			},
+			isLBTrafficShiftMinHealthyMembers: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_traffic_shift", isLBTrafficShiftMinHealthyMembers),
+				Description:  "The number of members of the target pool that must report `ok` health before traffic is switched. 0 requires all members to be healthy.",
+			},
+			isLBTrafficShiftRollback: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to switch the listener back to the previous pool when the target pool becomes unhealthy after the switch.",
+			},
+			isLBTrafficShiftObservationPeriod: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validate.InvokeValidator("ibm_is_lb_traffic_shift", isLBTrafficShiftObservationPeriod),
+				Description:  "The number of seconds the target pool is observed after the switch. The switch fails, and is rolled back when `rollback_on_failure` is set, as soon as fewer than the required members are healthy. 0 disables the observation.",
+			},
+			isLBTrafficShiftActivePoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The pool currently set as the default pool of the listener.",
+			},
+			isLBTrafficShiftPreviousPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default pool of the listener before the last switch.",
+			},
+			isLBTrafficShiftLastShiftAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last switch of the default pool.",
+			},
+		},
+	}
+}
+
+func ResourceIBMISLBTrafficShiftValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBTrafficShiftActive,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "blue, green"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBTrafficShiftMinHealthyMembers,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBTrafficShiftObservationPeriod,
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"})
+
+	ibmISLBTrafficShiftValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_traffic_shift", Schema: validateSchema}
+	return &ibmISLBTrafficShiftValidator
+}
+
+func resourceIBMISLBTrafficShiftCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBTrafficShiftLB).(string)
+	lbListenerID := lbTrafficShiftLastPart(d.Get(isLBTrafficShiftListener).(string))
+
+	// The ID is set before the switch, so a listener that was switched before a
+	// later step failed stays tracked. Delete only removes the traffic shift
+	// from the state, so replacing the tainted resource retries the switch.
+	d.SetId(fmt.Sprintf("%s/%s", lbID, lbListenerID))
+	diags := lbTrafficShiftApply(context, d, meta, lbID, lbListenerID, "create", d.Timeout(schema.TimeoutCreate))
+	if diags != nil {
+		return diags
+	}
+
+	return resourceIBMISLBTrafficShiftRead(context, d, meta)
+}
+
+func resourceIBMISLBTrafficShiftRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "sep-id-parts").GetDiag()
+	}
+	if len(parts) < 2 {
+		err = fmt.Errorf("The id should contain loadbalancer Id and loadbalancer listener Id")
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "sep-id-parts").GetDiag()
+	}
+	lbID := parts[0]
+	lbListenerID := parts[1]
+
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbListenerID,
+	}
+	listener, response, err := sess.GetLoadBalancerListenerWithContext(context, getLoadBalancerListenerOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetLoadBalancerListenerWithContext failed: %s", err.Error()), "ibm_is_lb_traffic_shift", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set(isLBTrafficShiftLB, lbID); err != nil {
+		err = fmt.Errorf("Error setting lb: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "set-lb").GetDiag()
+	}
+	if err = d.Set(isLBTrafficShiftListener, lbListenerID); err != nil {
+		err = fmt.Errorf("Error setting listener: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "set-listener").GetDiag()
+	}
+
+	activePoolID := ""
+	if listener.DefaultPool != nil && listener.DefaultPool.ID != nil {
+		activePoolID = *listener.DefaultPool.ID
+	}
+	if err = d.Set(isLBTrafficShiftActivePoolID, activePoolID); err != nil {
+		err = fmt.Errorf("Error setting active_pool_id: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "set-active_pool_id").GetDiag()
+	}
+
+	// Report a default pool that was changed outside of Terraform as drift of `active`.
+	switch activePoolID {
+	case lbTrafficShiftLastPart(d.Get(isLBTrafficShiftBluePool).(string)):
+		err = d.Set(isLBTrafficShiftActive, isLBTrafficShiftBlue)
+	case lbTrafficShiftLastPart(d.Get(isLBTrafficShiftGreenPool).(string)):
+		err = d.Set(isLBTrafficShiftActive, isLBTrafficShiftGreen)
+	default:
+		log.Printf("[WARN] Default pool %q of listener %s is neither the blue nor the green pool", activePoolID, lbListenerID)
+		err = d.Set(isLBTrafficShiftActive, "")
+	}
+	if err != nil {
+		err = fmt.Errorf("Error setting active: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "read", "set-active").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISLBTrafficShiftUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "update", "sep-id-parts").GetDiag()
+	}
+	if len(parts) < 2 {
+		err = fmt.Errorf("The id should contain loadbalancer Id and loadbalancer listener Id")
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", "update", "sep-id-parts").GetDiag()
+	}
+	lbID := parts[0]
+	lbListenerID := parts[1]
+
+	if d.HasChange(isLBTrafficShiftActive) || d.HasChange(isLBTrafficShiftBluePool) || d.HasChange(isLBTrafficShiftGreenPool) {
+		diags := lbTrafficShiftApply(context, d, meta, lbID, lbListenerID, "update", d.Timeout(schema.TimeoutUpdate))
+		if diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMISLBTrafficShiftRead(context, d, meta)
+}
+
+// The listener and its pools are owned by other resources, deleting the traffic
+// shift only removes it from the state and leaves the current default pool in place.
+func resourceIBMISLBTrafficShiftDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// lbTrafficShiftApply switches the default pool of the listener to the pool
+// selected by `active` once enough of its members report healthy, and switches
+// back when the pool does not stay healthy during the observation period.
+func lbTrafficShiftApply(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID, operation string, timeout time.Duration) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_traffic_shift", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	targetPoolID := lbTrafficShiftLastPart(d.Get(isLBTrafficShiftBluePool).(string))
+	if d.Get(isLBTrafficShiftActive).(string) == isLBTrafficShiftGreen {
+		targetPoolID = lbTrafficShiftLastPart(d.Get(isLBTrafficShiftGreenPool).(string))
+	}
+	minHealthy := d.Get(isLBTrafficShiftMinHealthyMembers).(int)
+
+	getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
+		LoadBalancerID: &lbID,
+		ID:             &lbListenerID,
+	}
+	listener, _, err := sess.GetLoadBalancerListenerWithContext(context, getLoadBalancerListenerOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetLoadBalancerListenerWithContext failed: %s", err.Error()), "ibm_is_lb_traffic_shift", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	currentPoolID := ""
+	if listener.DefaultPool != nil && listener.DefaultPool.ID != nil {
+		currentPoolID = *listener.DefaultPool.ID
+	}
+	if currentPoolID == targetPoolID {
+		log.Printf("[INFO] Listener %s already forwards to pool %s", lbListenerID, targetPoolID)
+		return nil
+	}
+
+	_, err = isWaitForLBPoolMembersHealthy(sess, lbID, targetPoolID, minHealthy, timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBPoolMembersHealthy failed, traffic was not switched: %s", err.Error()), "ibm_is_lb_traffic_shift", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	err = lbTrafficShiftSetDefaultPool(context, sess, lbID, lbListenerID, targetPoolID, timeout)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Switching the default pool failed: %s", err.Error()), "ibm_is_lb_traffic_shift", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set(isLBTrafficShiftPreviousPoolID, currentPoolID)
+	d.Set(isLBTrafficShiftLastShiftAt, time.Now().UTC().Format(time.RFC3339))
+
+	// The members are observed once the listener is serving from the new pool.
+	observationPeriod := time.Duration(d.Get(isLBTrafficShiftObservationPeriod).(int)) * time.Second
+	err = lbTrafficShiftObservePool(context, sess, lbID, targetPoolID, minHealthy, observationPeriod)
+	if err != nil {
+		if !d.Get(isLBTrafficShiftRollback).(bool) || currentPoolID == "" {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Pool %s became unhealthy after the switch: %s", targetPoolID, err.Error()), "ibm_is_lb_traffic_shift", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		log.Printf("[WARN] Pool %s became unhealthy after the switch, rolling back to pool %s", targetPoolID, currentPoolID)
+		if rbErr := lbTrafficShiftSetDefaultPool(context, sess, lbID, lbListenerID, currentPoolID, timeout); rbErr != nil {
+			err = fmt.Errorf("%s, rollback to pool %s also failed: %s", err, currentPoolID, rbErr)
+		} else {
+			d.Set(isLBTrafficShiftPreviousPoolID, targetPoolID)
+			err = fmt.Errorf("%s, traffic was rolled back to pool %s", err, currentPoolID)
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Pool %s became unhealthy after the switch: %s", targetPoolID, err.Error()), "ibm_is_lb_traffic_shift", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	return nil
+}
+
+func lbTrafficShiftSetDefaultPool(context context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID, poolID string, timeout time.Duration) error {
+	isLBKey := "load_balancer_key_" + lbID
+	conns.IbmMutexKV.Lock(isLBKey)
+	defer conns.IbmMutexKV.Unlock(isLBKey)
+
+	_, err := isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("isWaitForLBAvailable failed: %s", err)
+	}
+	loadBalancerListenerPatchModel := &vpcv1.LoadBalancerListenerPatch{
+		DefaultPool: &vpcv1.LoadBalancerListenerDefaultPoolPatch{
+			ID: &poolID,
+		},
+	}
+	loadBalancerListenerPatch, err := loadBalancerListenerPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("loadBalancerListenerPatchModel.AsPatch() failed: %s", err)
+	}
+	updateLoadBalancerListenerOptions := &vpcv1.UpdateLoadBalancerListenerOptions{
+		LoadBalancerID:            &lbID,
+		ID:                        &lbListenerID,
+		LoadBalancerListenerPatch: loadBalancerListenerPatch,
+	}
+	_, response, err := sess.UpdateLoadBalancerListenerWithContext(context, updateLoadBalancerListenerOptions)
+	if err != nil {
+		return fmt.Errorf("UpdateLoadBalancerListenerWithContext failed: %s\n%s", err, response)
+	}
+	_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, timeout)
+	if err != nil {
+		return fmt.Errorf("isWaitForLBListenerAvailable failed: %s", err)
+	}
+	_, err = isWaitForLBAvailable(sess, lbID, timeout)
+	if err != nil {
+		return fmt.Errorf("isWaitForLBAvailable failed: %s", err)
+	}
+	return nil
+}
+
+// lbTrafficShiftObservePool polls the members of the pool until the period
+// elapses, and fails as soon as fewer than the required members are healthy.
+func lbTrafficShiftObservePool(context context.Context, sess *vpcv1.VpcV1, lbID, lbPoolID string, minHealthy int, period time.Duration) error {
+	if period <= 0 {
+		return nil
+	}
+	log.Printf("Observing members of load balancer pool (%s) for %s.", lbPoolID, period)
+
+	const interval = 10 * time.Second
+	refresh := isLBPoolMembersHealthRefreshFunc(sess, lbID, lbPoolID, minHealthy)
+	deadline := time.Now().Add(period)
+	for {
+		_, state, err := refresh()
+		if err != nil {
+			return err
+		}
+		if state != isLBTrafficShiftPoolHealthy {
+			return fmt.Errorf("[ERROR] Fewer than the required members of load balancer pool %s are healthy", lbPoolID)
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		if remaining > interval {
+			remaining = interval
+		}
+		select {
+		case <-context.Done():
+			return context.Err()
+		case <-time.After(remaining):
+		}
+	}
+}
+
+func isWaitForLBPoolMembersHealthy(sess *vpcv1.VpcV1, lbID, lbPoolID string, minHealthy int, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for members of load balancer pool (%s) to be healthy.", lbPoolID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isLBTrafficShiftPoolWaiting},
+		Target:     []string{isLBTrafficShiftPoolHealthy},
+		Refresh:    isLBPoolMembersHealthRefreshFunc(sess, lbID, lbPoolID, minHealthy),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isLBPoolMembersHealthRefreshFunc(sess *vpcv1.VpcV1, lbID, lbPoolID string, minHealthy int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         &lbPoolID,
+		}
+		members, response, err := sess.ListLoadBalancerPoolMembers(listLoadBalancerPoolMembersOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Members: %s\n%s", err, response)
+		}
+		if len(members.Members) == 0 {
+			return members, "", fmt.Errorf("[ERROR] Load balancer pool %s has no members", lbPoolID)
+		}
+
+		required := minHealthy
+		if required == 0 || required > len(members.Members) {
+			required = len(members.Members)
+		}
+		healthy := 0
+		unhealthy := []string{}
+		for _, member := range members.Members {
+			if member.Health != nil && *member.Health == vpcv1.LoadBalancerPoolMemberHealthOkConst {
+				healthy++
+			} else if member.ID != nil && member.Health != nil {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s(%s)", *member.ID, *member.Health))
+			}
+		}
+		log.Printf("[DEBUG] Load balancer pool %s has %d of %d required healthy members, unhealthy: %s", lbPoolID, healthy, required, strings.Join(unhealthy, ", "))
+		if healthy >= required {
+			return members, isLBTrafficShiftPoolHealthy, nil
+		}
+		return members, isLBTrafficShiftPoolWaiting, nil
+	}
+}
+
+func suppressLBTrafficShiftPoolID(k, o, n string, d *schema.ResourceData) bool {
+	// accept both the pool id and the <lb>/<pool> id of ibm_is_lb_pool
+	return o != "" && lbTrafficShiftLastPart(o) == lbTrafficShiftLastPart(n)
+}
+
+func lbTrafficShiftLastPart(id string) string {
+	parts := strings.Split(id, "/")
+	return parts[len(parts)-1]
+}
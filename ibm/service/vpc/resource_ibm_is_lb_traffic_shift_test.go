@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISLBTrafficShift_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbts-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbts-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tflbts-ssh-%d", acctest.RandIntRange(10, 100))
+	vsiName := fmt.Sprintf("tflbts-vsi-%d", acctest.RandIntRange(10, 100))
+	lbName := fmt.Sprintf("tflbts-lb-%d", acctest.RandIntRange(10, 100))
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBTrafficShiftConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, vsiName, lbName, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb_traffic_shift.testacc_shift", "active", "blue"),
+					resource.TestCheckResourceAttrPair("ibm_is_lb_traffic_shift.testacc_shift", "active_pool_id", "ibm_is_lb_pool.testacc_blue", "pool_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBTrafficShiftConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, vsiName, lbName, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb_traffic_shift.testacc_shift", "active", "green"),
+					resource.TestCheckResourceAttrPair("ibm_is_lb_traffic_shift.testacc_shift", "active_pool_id", "ibm_is_lb_pool.testacc_green", "pool_id"),
+					resource.TestCheckResourceAttrPair("ibm_is_lb_traffic_shift.testacc_shift", "previous_pool_id", "ibm_is_lb_pool.testacc_blue", "pool_id"),
+					resource.TestCheckResourceAttrSet("ibm_is_lb_traffic_shift.testacc_shift", "last_shift_at"),
+				),
+			},
+			{
+				ResourceName:            "ibm_is_lb_traffic_shift.testacc_shift",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"blue_pool", "green_pool", "active", "min_healthy_members", "rollback_on_failure", "observation_period", "previous_pool_id", "last_shift_at"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBTrafficShiftConfig(vpcname, subnetname, zone, cidr, sshname, publicKey, vsiName, lbName, active string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+	resource "ibm_is_instance" "testacc_instance" {
+		count   = 2
+		name    = "%s-${count.index}"
+		image   = "%s"
+		profile = "%s"
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name    = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+	resource "ibm_is_lb_pool" "testacc_blue" {
+		name           = "blue"
+		lb             = ibm_is_lb.testacc_LB.id
+		algorithm      = "round_robin"
+		protocol       = "tcp"
+		health_delay   = 5
+		health_retries = 2
+		health_timeout = 2
+		health_type    = "tcp"
+	}
+	resource "ibm_is_lb_pool" "testacc_green" {
+		name           = "green"
+		lb             = ibm_is_lb.testacc_LB.id
+		algorithm      = "round_robin"
+		protocol       = "tcp"
+		health_delay   = 5
+		health_retries = 2
+		health_timeout = 2
+		health_type    = "tcp"
+	}
+	resource "ibm_is_lb_pool_member" "testacc_blue_member" {
+		lb             = ibm_is_lb.testacc_LB.id
+		pool           = ibm_is_lb_pool.testacc_blue.pool_id
+		port           = 22
+		target_address = ibm_is_instance.testacc_instance[0].primary_network_interface[0].primary_ip[0].address
+	}
+	resource "ibm_is_lb_pool_member" "testacc_green_member" {
+		lb             = ibm_is_lb.testacc_LB.id
+		pool           = ibm_is_lb_pool.testacc_green.pool_id
+		port           = 22
+		target_address = ibm_is_instance.testacc_instance[1].primary_network_interface[0].primary_ip[0].address
+	}
+	resource "ibm_is_lb_listener" "testacc_listener" {
+		lb           = ibm_is_lb.testacc_LB.id
+		port         = 8022
+		protocol     = "tcp"
+		default_pool = ibm_is_lb_pool.testacc_blue.id
+		lifecycle {
+			ignore_changes = [default_pool]
+		}
+	}
+	resource "ibm_is_lb_traffic_shift" "testacc_shift" {
+		lb         = ibm_is_lb.testacc_LB.id
+		listener   = ibm_is_lb_listener.testacc_listener.listener_id
+		blue_pool  = ibm_is_lb_pool.testacc_blue.pool_id
+		green_pool = ibm_is_lb_pool.testacc_green.pool_id
+		active     = "%s"
+		depends_on = [ibm_is_lb_pool_member.testacc_blue_member, ibm_is_lb_pool_member.testacc_green_member]
+	}`, vpcname, subnetname, zone, cidr, sshname, publicKey, vsiName, acc.IsImage, acc.InstanceProfileName, zone, lbName, active)
+}
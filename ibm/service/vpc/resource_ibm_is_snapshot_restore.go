@@ -0,0 +1,621 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	isSnapshotRestoreConsistencyGroup             = "source_snapshot_consistency_group"
+	isSnapshotRestoreSourceSnapshots              = "source_snapshots"
+	isSnapshotRestoreSourceRegion                 = "source_region"
+	isSnapshotRestoreZone                         = "zone"
+	isSnapshotRestoreVolumeProfile                = "volume_profile"
+	isSnapshotRestoreVolumeNamePrefix             = "volume_name_prefix"
+	isSnapshotRestoreEncryptionKey                = "encryption_key"
+	isSnapshotRestoreResourceGroup                = "resource_group"
+	isSnapshotRestoreTargetInstance               = "target_instance"
+	isSnapshotRestoreDeleteVolumeOnInstanceDelete = "delete_volume_on_instance_delete"
+	isSnapshotRestoreDeleteOnDestroy              = "delete_on_destroy"
+	isSnapshotRestoreVolumes                      = "volumes"
+)
+
+func ResourceIBMISSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotRestoreCreate,
+		ReadContext:   resourceIBMISSnapshotRestoreRead,
+		UpdateContext: resourceIBMISSnapshotRestoreUpdate,
+		DeleteContext: resourceIBMISSnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotRestoreConsistencyGroup: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isSnapshotRestoreConsistencyGroup, isSnapshotRestoreSourceSnapshots},
+				Description:  "The ID or CRN of the snapshot consistency group to restore. All snapshots of the group are restored.",
+			},
+			isSnapshotRestoreSourceSnapshots: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ExactlyOneOf: []string{isSnapshotRestoreConsistencyGroup, isSnapshotRestoreSourceSnapshots},
+				Description:  "The IDs or CRNs of the snapshots to restore.",
+			},
+			isSnapshotRestoreSourceRegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The region of the source snapshots. Defaults to the region in the source CRNs, or the provider region for IDs. Snapshots in another region than the provider region are copied to the provider region first.",
+			},
+			isSnapshotRestoreZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the provider region to create the volumes in.",
+			},
+			isSnapshotRestoreVolumeProfile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "general-purpose",
+				Description: "The profile of the restored volumes.",
+			},
+			isSnapshotRestoreVolumeNamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+				Description:  "The prefix of the names of the snapshot copies and restored volumes. The name of the source volume is appended to it.",
+			},
+			isSnapshotRestoreEncryptionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the root key used to encrypt the snapshot copies and restored volumes.",
+			},
+			isSnapshotRestoreResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The resource group of the snapshot copies and restored volumes.",
+			},
+			isSnapshotRestoreTargetInstance: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the instance to attach the restored volumes to.",
+			},
+			isSnapshotRestoreDeleteVolumeOnInstanceDelete: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{isSnapshotRestoreTargetInstance},
+				Description:  "Whether the restored volumes are deleted when the target instance is deleted.",
+			},
+			isSnapshotRestoreDeleteOnDestroy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the restored volumes and snapshot copies are deleted when the resource is destroyed. When false, they are only detached and removed from the state.",
+			},
+			isSnapshotRestoreVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The restored volumes, one per source snapshot.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_snapshot_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the source snapshot.",
+						},
+						"source_volume_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the volume the source snapshot was taken from.",
+						},
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the snapshot in the provider region the volume was restored from.",
+						},
+						"snapshot_copied": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the snapshot was copied from the source region by this resource.",
+						},
+						"volume_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the restored volume.",
+						},
+						"volume_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the restored volume.",
+						},
+						"volume_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the restored volume, `deleted` when the volume no longer exists.",
+						},
+						"volume_attachment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the attachment of the volume to the target instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// snapshotRestoreItem tracks the progress of one source snapshot through the
+// copy, restore and attach steps.
+type snapshotRestoreItem struct {
+	sourceCRN          string
+	sourceVolumeName   string
+	snapshotID         string
+	snapshotCopied     bool
+	volumeID           string
+	volumeName         string
+	volumeStatus       string
+	volumeAttachmentID string
+}
+
+func resourceIBMISSnapshotRestoreCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_restore", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	bmxSess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_restore", "create", "session-initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	region := bmxSess.Config.Region
+
+	sourceRegion, sourceID, items, err := snapshotRestoreResolveSources(context, d, sess, region)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error resolving the source snapshots: %s", err.Error()), "ibm_is_snapshot_restore", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if err = d.Set(isSnapshotRestoreSourceRegion, sourceRegion); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting source_region: %s", err), "ibm_is_snapshot_restore", "create", "set-source_region").GetDiag()
+	}
+
+	zone := d.Get(isSnapshotRestoreZone).(string)
+	d.SetId(fmt.Sprintf("%s/%s", sourceID, zone))
+
+	namePrefix := d.Get(isSnapshotRestoreVolumeNamePrefix).(string)
+	var encryptionKey *vpcv1.EncryptionKeyIdentity
+	if key, ok := d.GetOk(isSnapshotRestoreEncryptionKey); ok {
+		encryptionKey = &vpcv1.EncryptionKeyIdentity{CRN: flex.PtrToString(key.(string))}
+	}
+	var resourceGroup *vpcv1.ResourceGroupIdentity
+	if rg, ok := d.GetOk(isSnapshotRestoreResourceGroup); ok {
+		resourceGroup = &vpcv1.ResourceGroupIdentity{ID: flex.PtrToString(rg.(string))}
+	}
+
+	// copy the snapshots of other regions first, the copies are started together
+	// and waited for afterwards so the transfers run in parallel
+	if sourceRegion != region {
+		for _, item := range items {
+			prototype := &vpcv1.SnapshotPrototypeSnapshotBySourceSnapshot{
+				SourceSnapshot: &vpcv1.SnapshotIdentityByCRN{CRN: &item.sourceCRN},
+			}
+			if encryptionKey != nil {
+				prototype.EncryptionKey = encryptionKey
+			}
+			if resourceGroup != nil {
+				prototype.ResourceGroup = resourceGroup
+			}
+			if namePrefix != "" {
+				prototype.Name = flex.PtrToString(fmt.Sprintf("%s-%s", namePrefix, item.sourceVolumeName))
+			}
+			snapshot, response, err := sess.CreateSnapshotWithContext(context, &vpcv1.CreateSnapshotOptions{SnapshotPrototype: prototype})
+			if err != nil {
+				snapshotRestoreSetVolumes(d, items)
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSnapshotWithContext failed copying %s: %s\n%s", item.sourceCRN, err.Error(), response), "ibm_is_snapshot_restore", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			item.snapshotID, item.snapshotCopied = *snapshot.ID, true
+			log.Printf("[INFO] Copying snapshot %s to %s as %s", item.sourceCRN, region, item.snapshotID)
+		}
+		snapshotRestoreSetVolumes(d, items)
+		for _, item := range items {
+			if _, err = isWaitForSnapshotAvailable(sess, item.snapshotID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSnapshotAvailable failed for copy of %s: %s", item.sourceCRN, err.Error()), "ibm_is_snapshot_restore", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	profile := d.Get(isSnapshotRestoreVolumeProfile).(string)
+	for _, item := range items {
+		volTemplate := &vpcv1.VolumePrototype{
+			Zone:           &vpcv1.ZoneIdentity{Name: &zone},
+			Profile:        &vpcv1.VolumeProfileIdentity{Name: &profile},
+			SourceSnapshot: &vpcv1.SnapshotIdentity{ID: flex.PtrToString(item.snapshotID)},
+		}
+		if encryptionKey != nil {
+			volTemplate.EncryptionKey = encryptionKey
+		}
+		if resourceGroup != nil {
+			volTemplate.ResourceGroup = resourceGroup
+		}
+		if namePrefix != "" {
+			volTemplate.Name = flex.PtrToString(fmt.Sprintf("%s-%s", namePrefix, item.sourceVolumeName))
+		}
+		vol, response, err := sess.CreateVolumeWithContext(context, &vpcv1.CreateVolumeOptions{VolumePrototype: volTemplate})
+		if err != nil {
+			snapshotRestoreSetVolumes(d, items)
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateVolumeWithContext failed restoring %s: %s\n%s", item.snapshotID, err.Error(), response), "ibm_is_snapshot_restore", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		item.volumeID, item.volumeName, item.volumeStatus = *vol.ID, *vol.Name, *vol.Status
+		log.Printf("[INFO] Restoring snapshot %s to volume %s", item.snapshotID, item.volumeID)
+	}
+	snapshotRestoreSetVolumes(d, items)
+	for _, item := range items {
+		if _, err = isWaitForVolumeAvailable(sess, item.volumeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeAvailable failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	if instanceID, ok := d.GetOk(isSnapshotRestoreTargetInstance); ok {
+		instanceId := instanceID.(string)
+		deleteVolume := d.Get(isSnapshotRestoreDeleteVolumeOnInstanceDelete).(bool)
+		isInstanceKey := "instance_key_" + instanceId
+		conns.IbmMutexKV.Lock(isInstanceKey)
+		defer conns.IbmMutexKV.Unlock(isInstanceKey)
+		for _, item := range items {
+			instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachmentWithContext(context, &vpcv1.CreateInstanceVolumeAttachmentOptions{
+				InstanceID:                   &instanceId,
+				Volume:                       &vpcv1.VolumeAttachmentPrototypeVolumeVolumeIdentity{ID: flex.PtrToString(item.volumeID)},
+				DeleteVolumeOnInstanceDelete: &deleteVolume,
+			})
+			if err != nil {
+				snapshotRestoreSetVolumes(d, items)
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateInstanceVolumeAttachmentWithContext failed for %s: %s\n%s", item.volumeID, err.Error(), response), "ibm_is_snapshot_restore", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			item.volumeAttachmentID = *instanceVolAtt.ID
+			snapshotRestoreSetVolumes(d, items)
+			if _, err = isWaitForInstanceVolumeAttached(sess, d, instanceId, item.volumeAttachmentID); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeAttached failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "create")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	return resourceIBMISSnapshotRestoreRead(context, d, meta)
+}
+
+// snapshotRestoreResolveSources returns the source region, an identifier of the
+// source and the source snapshots, which must all be stable.
+func snapshotRestoreResolveSources(context context.Context, d *schema.ResourceData, sess *vpcv1.VpcV1, region string) (string, string, []*snapshotRestoreItem, error) {
+	refs := []string{}
+	if cg, ok := d.GetOk(isSnapshotRestoreConsistencyGroup); ok {
+		refs = append(refs, cg.(string))
+	} else {
+		for _, s := range d.Get(isSnapshotRestoreSourceSnapshots).(*schema.Set).List() {
+			refs = append(refs, s.(string))
+		}
+	}
+
+	sourceRegion := d.Get(isSnapshotRestoreSourceRegion).(string)
+	for _, ref := range refs {
+		crnRegion := snapshotRestoreCRNRegion(ref)
+		if crnRegion == "" {
+			continue
+		}
+		if sourceRegion == "" {
+			sourceRegion = crnRegion
+		} else if sourceRegion != crnRegion {
+			return "", "", nil, fmt.Errorf("source %s is in region %s, expected %s, all sources must be in the same region", ref, crnRegion, sourceRegion)
+		}
+	}
+	if sourceRegion == "" {
+		sourceRegion = region
+	}
+	sourceSess, err := vpcClientForRegion(sess, region, sourceRegion)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	snapshotIDs := []string{}
+	sourceID := snapshotRestoreCRNID(refs[0])
+	if _, ok := d.GetOk(isSnapshotRestoreConsistencyGroup); ok {
+		cg, response, err := sourceSess.GetSnapshotConsistencyGroupWithContext(context, &vpcv1.GetSnapshotConsistencyGroupOptions{ID: &sourceID})
+		if err != nil {
+			return "", "", nil, fmt.Errorf("GetSnapshotConsistencyGroupWithContext failed in %s: %s\n%s", sourceRegion, err, response)
+		}
+		if *cg.LifecycleState != vpcv1.SnapshotConsistencyGroupLifecycleStateStableConst {
+			return "", "", nil, fmt.Errorf("snapshot consistency group %s is %s, it must be stable to be restored", sourceID, *cg.LifecycleState)
+		}
+		for _, snapshot := range cg.Snapshots {
+			snapshotIDs = append(snapshotIDs, *snapshot.ID)
+		}
+		if len(snapshotIDs) == 0 {
+			return "", "", nil, fmt.Errorf("snapshot consistency group %s has no snapshots", sourceID)
+		}
+	} else {
+		for _, ref := range refs {
+			snapshotIDs = append(snapshotIDs, snapshotRestoreCRNID(ref))
+		}
+	}
+
+	items := make([]*snapshotRestoreItem, 0, len(snapshotIDs))
+	for _, id := range snapshotIDs {
+		snapshot, response, err := sourceSess.GetSnapshotWithContext(context, &vpcv1.GetSnapshotOptions{ID: flex.PtrToString(id)})
+		if err != nil {
+			return "", "", nil, fmt.Errorf("GetSnapshotWithContext failed for %s in %s: %s\n%s", id, sourceRegion, err, response)
+		}
+		if *snapshot.LifecycleState != isSnapshotAvailable {
+			return "", "", nil, fmt.Errorf("snapshot %s is %s, it must be %s to be restored", id, *snapshot.LifecycleState, isSnapshotAvailable)
+		}
+		item := &snapshotRestoreItem{sourceCRN: *snapshot.CRN, snapshotID: *snapshot.ID}
+		if snapshot.SourceVolume != nil && snapshot.SourceVolume.Name != nil {
+			item.sourceVolumeName = *snapshot.SourceVolume.Name
+		} else {
+			item.sourceVolumeName = *snapshot.Name
+		}
+		items = append(items, item)
+	}
+	return sourceRegion, sourceID, items, nil
+}
+
+func resourceIBMISSnapshotRestoreRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_restore", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	items := snapshotRestoreGetVolumes(d)
+	deleted := 0
+	for _, item := range items {
+		if item.volumeID == "" {
+			continue
+		}
+		vol, response, err := sess.GetVolumeWithContext(context, &vpcv1.GetVolumeOptions{ID: &item.volumeID})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				item.volumeStatus = "deleted"
+				item.volumeAttachmentID = ""
+				deleted++
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVolumeWithContext failed: %s", err.Error()), "ibm_is_snapshot_restore", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		item.volumeName, item.volumeStatus = *vol.Name, *vol.Status
+		if item.volumeAttachmentID != "" {
+			attached := false
+			for _, att := range vol.VolumeAttachments {
+				if att.ID != nil && *att.ID == item.volumeAttachmentID {
+					attached = true
+				}
+			}
+			if !attached {
+				item.volumeAttachmentID = ""
+			}
+		}
+	}
+	if len(items) > 0 && deleted == len(items) {
+		log.Printf("[WARN] All volumes restored by %s were deleted, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err = snapshotRestoreSetVolumes(d, items); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting volumes: %s", err), "ibm_is_snapshot_restore", "read", "set-volumes").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSnapshotRestoreUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only delete_on_destroy can change in place and it is used on delete only
+	return resourceIBMISSnapshotRestoreRead(context, d, meta)
+}
+
+func resourceIBMISSnapshotRestoreDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_snapshot_restore", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	items := snapshotRestoreGetVolumes(d)
+	deleteAll := d.Get(isSnapshotRestoreDeleteOnDestroy).(bool)
+	instanceId := d.Get(isSnapshotRestoreTargetInstance).(string)
+	if instanceId != "" {
+		isInstanceKey := "instance_key_" + instanceId
+		conns.IbmMutexKV.Lock(isInstanceKey)
+		defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	}
+
+	for _, item := range items {
+		if item.volumeAttachmentID != "" {
+			response, err := sess.DeleteInstanceVolumeAttachmentWithContext(context, &vpcv1.DeleteInstanceVolumeAttachmentOptions{
+				InstanceID: &instanceId,
+				ID:         &item.volumeAttachmentID,
+			})
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteInstanceVolumeAttachmentWithContext failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "delete")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			if _, err = isWaitForInstanceVolumeDetached(sess, d, instanceId, item.volumeAttachmentID); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceVolumeDetached failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "delete")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+		if !deleteAll || item.volumeID == "" {
+			continue
+		}
+		response, err := sess.DeleteVolumeWithContext(context, &vpcv1.DeleteVolumeOptions{ID: &item.volumeID})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteVolumeWithContext failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if _, err = isWaitForVolumeDeleted(sess, item.volumeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForVolumeDeleted failed for %s: %s", item.volumeID, err.Error()), "ibm_is_snapshot_restore", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	if deleteAll {
+		for _, item := range items {
+			if !item.snapshotCopied {
+				continue
+			}
+			response, err := sess.DeleteSnapshotWithContext(context, &vpcv1.DeleteSnapshotOptions{ID: &item.snapshotID})
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					continue
+				}
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSnapshotWithContext failed for %s: %s", item.snapshotID, err.Error()), "ibm_is_snapshot_restore", "delete")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			if _, err = isWaitForSnapshotDeleted(sess, item.snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForSnapshotDeleted failed for %s: %s", item.snapshotID, err.Error()), "ibm_is_snapshot_restore", "delete")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func snapshotRestoreGetVolumes(d *schema.ResourceData) []*snapshotRestoreItem {
+	items := []*snapshotRestoreItem{}
+	for _, v := range d.Get(isSnapshotRestoreVolumes).([]interface{}) {
+		m := v.(map[string]interface{})
+		items = append(items, &snapshotRestoreItem{
+			sourceCRN:          m["source_snapshot_crn"].(string),
+			sourceVolumeName:   m["source_volume_name"].(string),
+			snapshotID:         m["snapshot_id"].(string),
+			snapshotCopied:     m["snapshot_copied"].(bool),
+			volumeID:           m["volume_id"].(string),
+			volumeName:         m["volume_name"].(string),
+			volumeStatus:       m["volume_status"].(string),
+			volumeAttachmentID: m["volume_attachment_id"].(string),
+		})
+	}
+	return items
+}
+
+// snapshotRestoreSetVolumes records the progress so far, a failed create leaves
+// enough in the state for delete to clean up what was created.
+func snapshotRestoreSetVolumes(d *schema.ResourceData, items []*snapshotRestoreItem) error {
+	volumes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		volumes = append(volumes, map[string]interface{}{
+			"source_snapshot_crn":  item.sourceCRN,
+			"source_volume_name":   item.sourceVolumeName,
+			"snapshot_id":          item.snapshotID,
+			"snapshot_copied":      item.snapshotCopied,
+			"volume_id":            item.volumeID,
+			"volume_name":          item.volumeName,
+			"volume_status":        item.volumeStatus,
+			"volume_attachment_id": item.volumeAttachmentID,
+		})
+	}
+	return d.Set(isSnapshotRestoreVolumes, volumes)
+}
+
+// snapshotRestoreCRNRegion returns the region of a CRN, or "" for an ID.
+// crn:v1:bluemix:public:is:us-south:a/123456::snapshot:r006-...
+func snapshotRestoreCRNRegion(ref string) string {
+	parts := strings.Split(ref, ":")
+	if len(parts) < 10 || parts[0] != "crn" {
+		return ""
+	}
+	return parts[5]
+}
+
+func snapshotRestoreCRNID(ref string) string {
+	if snapshotRestoreCRNRegion(ref) == "" {
+		return ref
+	}
+	parts := strings.Split(ref, ":")
+	return parts[len(parts)-1]
+}
+
+// vpcClientForRegion returns a copy of the VPC client that sends its requests
+// to the regional endpoint of the given region. The public endpoint is looked
+// up in the SDK, the region of a private or custom endpoint is replaced in its
+// host name.
+func vpcClientForRegion(sess *vpcv1.VpcV1, region, target string) (*vpcv1.VpcV1, error) {
+	if target == region {
+		return sess, nil
+	}
+	current := sess.Service.GetServiceURL()
+	var serviceURL string
+	if public, err := vpcv1.GetServiceURLForRegion(region); err == nil && public == current {
+		serviceURL, err = vpcv1.GetServiceURLForRegion(target)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error getting the VPC endpoint of region %s: %s", target, err)
+		}
+	} else {
+		serviceURL = strings.Replace(current, "//"+region+".", "//"+target+".", 1)
+		if serviceURL == current {
+			return nil, fmt.Errorf("[ERROR] The VPC endpoint %s is not in region %s, the endpoint of region %s cannot be derived from it", current, region, target)
+		}
+	}
+	log.Printf("[DEBUG] Using VPC endpoint %s for region %s", serviceURL, target)
+	client := sess.Clone()
+	if err := client.Service.SetServiceURL(serviceURL); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSnapshotRestore_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	cgname := fmt.Sprintf("tf-cg-%d", acctest.RandIntRange(10, 100))
+	prefix := fmt.Sprintf("tf-restore-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotRestoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotRestoreConfig(vpcname, subnetname, sshname, publicKey, volname, name, cgname, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_restore.testacc_restore", "id"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_restore.testacc_restore", "source_region", acc.RegionName),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_restore.testacc_restore", "volumes.#", "2"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_restore.testacc_restore", "volumes.0.snapshot_copied", "false"),
+					resource.TestCheckResourceAttr("ibm_is_snapshot_restore.testacc_restore", "volumes.0.volume_status", "available"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_restore.testacc_restore", "volumes.0.volume_attachment_id"),
+					resource.TestCheckResourceAttrSet("ibm_is_snapshot_restore.testacc_restore", "volumes.1.volume_attachment_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotRestoreDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_snapshot_restore" {
+			continue
+		}
+		for i := 0; i < 2; i++ {
+			volumeID := rs.Primary.Attributes[fmt.Sprintf("volumes.%d.volume_id", i)]
+			if volumeID == "" {
+				continue
+			}
+			_, response, err := sess.GetVolume(&vpcv1.GetVolumeOptions{ID: &volumeID})
+			if err == nil || response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("restored volume still exists: %s", volumeID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSnapshotRestoreConfig(vpcname, subnetname, sshname, publicKey, volname, name, cgname, prefix string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_volume" "testacc_volume" {
+		name    = "%s"
+		profile = "general-purpose"
+		zone    = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc     = ibm_is_vpc.testacc_vpc.id
+		zone    = "%s"
+		keys    = [ibm_is_ssh_key.testacc_sshkey.id]
+		volumes = [ibm_is_volume.testacc_volume.id]
+	}
+
+	resource "ibm_is_snapshot_consistency_group" "testacc_cg" {
+		name                       = "%s"
+		delete_snapshots_on_delete = true
+		snapshot_reference {
+			name          = "%s-boot"
+			source_volume = ibm_is_instance.testacc_instance.volume_attachments[0].volume_id
+		}
+		snapshot_reference {
+			name          = "%s-data"
+			source_volume = ibm_is_instance.testacc_instance.volume_attachments[1].volume_id
+		}
+	}
+
+	resource "ibm_is_instance" "testacc_target" {
+		name    = "%s-target"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	}
+
+	resource "ibm_is_snapshot_restore" "testacc_restore" {
+		source_snapshot_consistency_group = ibm_is_snapshot_consistency_group.testacc_cg.id
+		zone                              = "%s"
+		volume_name_prefix                = "%s"
+		target_instance                   = ibm_is_instance.testacc_target.id
+	}`, vpcname, subnetname, acc.ISZoneName, sshname, publicKey, volname, acc.ISZoneName, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName,
+		cgname, cgname, cgname, name, acc.IsImage, acc.InstanceProfileName, acc.ISZoneName, acc.ISZoneName, prefix)
+}
@@ -127,6 +127,13 @@ func ValidateUTCFormat(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func ValidateTimeZone(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IANA time zone name, for example Europe/Berlin: %s", k, err))
+	}
+	return
+}
+
 func validateRegexpLen(min, max int, regex string) schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
 		value := v.(string)